@@ -31,7 +31,8 @@ type Type interface {
 	// ValueType should return the attr.Value type returned by
 	// ValueFromTerraform. The returned attr.Value can be any null, unknown,
 	// or known value for the type, as this is intended for type detection
-	// and improving error diagnostics.
+	// and improving error diagnostics. The framework-defined types return a
+	// null value, which generic logic can use as a zero value of the type.
 	ValueType(context.Context) Value
 
 	// Equal should return true if the Type is considered equivalent to the
@@ -13,6 +13,8 @@ import (
 // Data sources can optionally implement these additional concepts:
 //
 //   - Configure: Include provider-level data or clients.
+//   - Deprecation: Warn practitioners when the data source is read via
+//     DataSourceWithDeprecation.
 //   - Validation: Schema-based or entire configuration
 //     via DataSourceWithConfigValidators or DataSourceWithValidateConfig.
 type DataSource interface {
@@ -45,6 +47,20 @@ type DataSourceWithConfigure interface {
 	Configure(context.Context, ConfigureRequest, *ConfigureResponse)
 }
 
+// DataSourceWithDeprecation is an interface type that extends DataSource to
+// include a deprecation message, such as when the entire data source is
+// planned for removal in a future provider release. When the message is
+// non-empty, reading the data source returns a warning diagnostic containing
+// it, similar to attribute deprecation.
+type DataSourceWithDeprecation interface {
+	DataSource
+
+	// DeprecationMessage should return practitioner-facing guidance for the
+	// deprecation, such as the data source to use instead. An empty message
+	// means the data source is not deprecated.
+	DeprecationMessage(context.Context) string
+}
+
 // DataSourceWithConfigValidators is an interface type that extends DataSource to include declarative validations.
 //
 // Declaring validation using this methodology simplifies implmentation of
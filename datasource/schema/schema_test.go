@@ -1013,7 +1013,7 @@ func TestSchemaValidate(t *testing.T) {
 		"validate-implementation-error": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{Optional: true},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1056,7 +1056,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"attribute-using-reserved-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{Optional: true},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1089,8 +1089,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"depends_on": schema.BoolAttribute{},
+							"depends_on": schema.BoolAttribute{Optional: true},
 						},
 					},
 				},
@@ -1101,7 +1102,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"connection": schema.BoolAttribute{},
+							"connection": schema.BoolAttribute{Optional: true},
 						},
 					},
 				},
@@ -1110,7 +1111,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"attribute-and-blocks-using-reserved-field-names": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{Optional: true},
 				},
 				Blocks: map[string]schema.Block{
 					"connection": schema.ListNestedBlock{},
@@ -1136,7 +1137,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"attribute-using-invalid-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"^": schema.StringAttribute{},
+					"^": schema.StringAttribute{Optional: true},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1169,8 +1170,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{Optional: true},
 						},
 					},
 				},
@@ -1190,7 +1192,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{Optional: true},
 						},
 					},
 				},
@@ -1212,7 +1214,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 						Blocks: map[string]schema.Block{
 							"^": schema.SingleNestedBlock{
 								Attributes: map[string]schema.Attribute{
-									"!": schema.BoolAttribute{},
+									"!": schema.BoolAttribute{Optional: true},
 								},
 							},
 						},
@@ -1265,6 +1267,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"list_nested_attribute": schema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
 								"test": schema.ListAttribute{
@@ -14,3 +14,20 @@ func NewAttributeErrorDiagnostic(path path.Path, summary string, detail string)
 		path:       path,
 	}
 }
+
+// NewAttributeErrorDiagnosticWithSuggestion returns a new error severity
+// diagnostic with the given summary, detail, path, and suggested correction,
+// such as the closest allowed value for a mistyped configuration value. The
+// returned diagnostic implements DiagnosticWithSuggestion and its Detail
+// includes the suggestion. An empty suggestion returns the same diagnostic as
+// NewAttributeErrorDiagnostic.
+func NewAttributeErrorDiagnosticWithSuggestion(path path.Path, summary string, detail string, suggestion string) DiagnosticWithPath {
+	if suggestion == "" {
+		return NewAttributeErrorDiagnostic(path, summary, detail)
+	}
+
+	return withSuggestion{
+		DiagnosticWithPath: NewAttributeErrorDiagnostic(path, summary, detail),
+		suggestion:         suggestion,
+	}
+}
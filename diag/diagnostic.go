@@ -51,3 +51,25 @@ type DiagnosticWithPath interface {
 	// supporting implementations such as Terraform CLI commands.
 	Path() path.Path
 }
+
+// DiagnosticWithSuggestion is a diagnostic which includes a suggested
+// correction, such as the closest allowed value for a mistyped configuration
+// value. The suggestion is also included in the Detail of the diagnostic, so
+// it is displayed to practitioners.
+type DiagnosticWithSuggestion interface {
+	Diagnostic
+
+	// Suggestion returns the suggested correction.
+	Suggestion() string
+}
+
+// DiagnosticWithCode is a diagnostic which includes a machine-readable code,
+// such as for matching diagnostics in tests or branching on specific errors
+// in provider logic, where the practitioner facing summary and detail are
+// not suitable. The code is not sent to Terraform.
+type DiagnosticWithCode interface {
+	Diagnostic
+
+	// Code returns the machine-readable code.
+	Code() string
+}
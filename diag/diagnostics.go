@@ -4,6 +4,10 @@
 package diag
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -28,11 +32,23 @@ func (diags *Diagnostics) AddError(summary string, detail string) {
 	diags.Append(NewErrorDiagnostic(summary, detail))
 }
 
+// AddErrorf adds a generic error diagnostic to the collection, with the
+// detail formatted according to fmt.Sprintf semantics.
+func (diags *Diagnostics) AddErrorf(summary string, format string, args ...interface{}) {
+	diags.AddError(summary, fmt.Sprintf(format, args...))
+}
+
 // AddWarning adds a generic warning diagnostic to the collection.
 func (diags *Diagnostics) AddWarning(summary string, detail string) {
 	diags.Append(NewWarningDiagnostic(summary, detail))
 }
 
+// AddWarningf adds a generic warning diagnostic to the collection, with the
+// detail formatted according to fmt.Sprintf semantics.
+func (diags *Diagnostics) AddWarningf(summary string, format string, args ...interface{}) {
+	diags.AddWarning(summary, fmt.Sprintf(format, args...))
+}
+
 // Append adds non-empty and non-duplicate diagnostics to the collection.
 func (diags *Diagnostics) Append(in ...Diagnostic) {
 	for _, diag := range in {
@@ -52,6 +68,17 @@ func (diags *Diagnostics) Append(in ...Diagnostic) {
 	}
 }
 
+// AppendError adds a generic error diagnostic with the given summary and the
+// error message as the detail to the collection. A nil error is skipped. This
+// is intended for bridging calls which return Go errors, such as API clients.
+func (diags *Diagnostics) AppendError(err error, summary string) {
+	if err == nil {
+		return
+	}
+
+	diags.AddError(summary, err.Error())
+}
+
 // Contains returns true if the collection contains an equal Diagnostic.
 func (diags Diagnostics) Contains(in Diagnostic) bool {
 	for _, diag := range diags {
@@ -79,6 +106,17 @@ func (diags Diagnostics) Equal(other Diagnostics) bool {
 	return true
 }
 
+// ExitCode returns a process exit code for the collection, which is 1 if the
+// collection has an error severity Diagnostic and 0 otherwise. This is
+// intended for command line tooling which reuses framework logic.
+func (diags Diagnostics) ExitCode() int {
+	if diags.HasError() {
+		return 1
+	}
+
+	return 0
+}
+
 // HasError returns true if the collection has an error severity Diagnostic.
 func (diags Diagnostics) HasError() bool {
 	for _, diag := range diags {
@@ -113,6 +151,51 @@ func (diags Diagnostics) Errors() Diagnostics {
 	return dd
 }
 
+// Paths returns the paths of all DiagnosticWithPath in Diagnostics, without
+// duplication and in the order they first appear. Diagnostics which are not
+// associated with an attribute path are skipped.
+func (diags Diagnostics) Paths() path.Paths {
+	var paths path.Paths
+
+	for _, d := range diags {
+		diagWithPath, ok := d.(DiagnosticWithPath)
+
+		if !ok {
+			continue
+		}
+
+		paths.Append(diagWithPath.Path())
+	}
+
+	return paths
+}
+
+// ToError returns an error containing the summary and detail of every
+// SeverityError Diagnostic in Diagnostics, or nil if there are none. Warnings
+// are ignored. This is intended for reusing framework logic outside of
+// Terraform operations, where a Go error is expected.
+func (diags Diagnostics) ToError() error {
+	errorDiags := diags.Errors()
+
+	if len(errorDiags) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(errorDiags))
+
+	for _, d := range errorDiags {
+		if d.Detail() == "" {
+			messages = append(messages, d.Summary())
+
+			continue
+		}
+
+		messages = append(messages, d.Summary()+": "+d.Detail())
+	}
+
+	return errors.New(strings.Join(messages, "\n\n"))
+}
+
 // Warnings returns all the Diagnostic in Diagnostics that are SeverityWarning.
 func (diags Diagnostics) Warnings() Diagnostics {
 	dd := Diagnostics{}
@@ -125,3 +208,35 @@ func (diags Diagnostics) Warnings() Diagnostics {
 
 	return dd
 }
+
+// NewErrorDiagnosticsFromErrors returns Diagnostics containing an error
+// severity diagnostic with the given summary for each non-nil error. The
+// detail of each diagnostic is the error message. This is intended for
+// surfacing multiple errors, such as those aggregated from API responses.
+func NewErrorDiagnosticsFromErrors(summary string, errs ...error) Diagnostics {
+	var diags Diagnostics
+
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		diags.AddError(summary, err.Error())
+	}
+
+	return diags
+}
+
+// Merge returns a new collection containing the diagnostics of a followed by
+// the diagnostics of b, with empty and duplicate diagnostics removed. The
+// first occurrence of each diagnostic determines its position. Neither a nor
+// b are modified. This is intended for combining the results of multiple
+// passes, such as validation, which may report the same diagnostics.
+func Merge(a Diagnostics, b Diagnostics) Diagnostics {
+	var result Diagnostics
+
+	result.Append(a...)
+	result.Append(b...)
+
+	return result
+}
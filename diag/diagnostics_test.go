@@ -4,6 +4,8 @@
 package diag_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -194,6 +196,68 @@ func TestDiagnosticsAddError(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsAddErrorf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		summary  string
+		format   string
+		args     []interface{}
+		expected diag.Diagnostics
+	}{
+		"nil-add": {
+			diags:   nil,
+			summary: "one summary",
+			format:  "one detail: %s (%d)",
+			args:    []interface{}{"test", 1},
+			expected: func() diag.Diagnostics {
+				var diags diag.Diagnostics
+				diags.AddError("one summary", "one detail: test (1)")
+				return diags
+			}(),
+		},
+		"add": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+			summary: "two summary",
+			format:  "two detail: %q",
+			args:    []interface{}{"test"},
+			expected: func() diag.Diagnostics {
+				diags := diag.Diagnostics{
+					diag.NewErrorDiagnostic("one summary", "one detail"),
+				}
+				diags.AddError("two summary", `two detail: "test"`)
+				return diags
+			}(),
+		},
+		"no-args": {
+			diags:   nil,
+			summary: "one summary",
+			format:  "one detail",
+			expected: func() diag.Diagnostics {
+				var diags diag.Diagnostics
+				diags.AddError("one summary", "one detail")
+				return diags
+			}(),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.diags.AddErrorf(tc.summary, tc.format, tc.args...)
+
+			if diff := cmp.Diff(tc.diags, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsAddWarning(t *testing.T) {
 	t.Parallel()
 
@@ -252,6 +316,68 @@ func TestDiagnosticsAddWarning(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsAddWarningf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		summary  string
+		format   string
+		args     []interface{}
+		expected diag.Diagnostics
+	}{
+		"nil-add": {
+			diags:   nil,
+			summary: "one summary",
+			format:  "one detail: %s (%d)",
+			args:    []interface{}{"test", 1},
+			expected: func() diag.Diagnostics {
+				var diags diag.Diagnostics
+				diags.AddWarning("one summary", "one detail: test (1)")
+				return diags
+			}(),
+		},
+		"add": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+			summary: "two summary",
+			format:  "two detail: %q",
+			args:    []interface{}{"test"},
+			expected: func() diag.Diagnostics {
+				diags := diag.Diagnostics{
+					diag.NewErrorDiagnostic("one summary", "one detail"),
+				}
+				diags.AddWarning("two summary", `two detail: "test"`)
+				return diags
+			}(),
+		},
+		"no-args": {
+			diags:   nil,
+			summary: "one summary",
+			format:  "one detail",
+			expected: func() diag.Diagnostics {
+				var diags diag.Diagnostics
+				diags.AddWarning("one summary", "one detail")
+				return diags
+			}(),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.diags.AddWarningf(tc.summary, tc.format, tc.args...)
+
+			if diff := cmp.Diff(tc.diags, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsAppend(t *testing.T) {
 	t.Parallel()
 
@@ -374,6 +500,66 @@ func TestDiagnosticsAppend(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsAppendError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		err      error
+		summary  string
+		expected diag.Diagnostics
+	}{
+		"nil-error": {
+			diags:    nil,
+			err:      nil,
+			summary:  "one summary",
+			expected: nil,
+		},
+		"nil-add": {
+			diags:   nil,
+			err:     errors.New("one detail"),
+			summary: "one summary",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+		},
+		"add": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("one summary", "one detail"),
+			},
+			err:     fmt.Errorf("wrapped: %w", errors.New("two detail")),
+			summary: "two summary",
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("one summary", "one detail"),
+				diag.NewErrorDiagnostic("two summary", "wrapped: two detail"),
+			},
+		},
+		"duplicate": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+			err:     errors.New("one detail"),
+			summary: "one summary",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.diags.AppendError(tc.err, tc.summary)
+
+			if diff := cmp.Diff(tc.diags, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsContains(t *testing.T) {
 	t.Parallel()
 
@@ -390,6 +576,15 @@ func TestDiagnosticsContains(t *testing.T) {
 			in:       diag.NewWarningDiagnostic("two summary", "two detail"),
 			expected: true,
 		},
+		"matching-position": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("one summary", "one detail"),
+				diag.NewErrorDiagnostic("two summary", "two detail"),
+				diag.NewWarningDiagnostic("three summary", "three detail"),
+			},
+			in:       diag.NewErrorDiagnostic("two summary", "two detail"),
+			expected: true,
+		},
 		"matching-attribute-path": {
 			diags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(path.Root("error"), "one summary", "one detail"),
@@ -602,6 +797,51 @@ func TestDiagnosticsEqual(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsExitCode(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		expected int
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags:    nil,
+			expected: 0,
+		},
+		"empty": {
+			diags:    diag.Diagnostics{},
+			expected: 0,
+		},
+		"warnings": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			expected: 0,
+		},
+		"errors": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+			},
+			expected: 1,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.diags.ExitCode()
+
+			if got != test.expected {
+				t.Fatalf("expected: %d, got: %d", test.expected, got)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsHasError(t *testing.T) {
 	t.Parallel()
 
@@ -787,6 +1027,126 @@ func TestDiagnosticsErrors(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsPaths(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		expected path.Paths
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags:    nil,
+			expected: nil,
+		},
+		"no-paths": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			expected: nil,
+		},
+		"mixed": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("error"), "Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("warning").AtListIndex(0), "Warning Summary", "Warning detail."),
+			},
+			expected: path.Paths{
+				path.Root("error"),
+				path.Root("warning").AtListIndex(0),
+			},
+		},
+		"duplicate-paths": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "Error detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("other"), "Warning Summary", "Warning detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Warning Summary", "Warning detail."),
+			},
+			expected: path.Paths{
+				path.Root("test"),
+				path.Root("other"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.diags.Paths()
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsToError(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		expected error
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags:    nil,
+			expected: nil,
+		},
+		"empty": {
+			diags:    diag.Diagnostics{},
+			expected: nil,
+		},
+		"warnings": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			expected: nil,
+		},
+		"error": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+			},
+			expected: errors.New("Error Summary: Error detail."),
+		},
+		"error-no-detail": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", ""),
+			},
+			expected: errors.New("Error Summary"),
+		},
+		"errors-and-warnings": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Attribute Error Summary", "Attribute error detail."),
+			},
+			expected: errors.New("Error Summary: Error detail.\n\nAttribute Error Summary: Attribute error detail."),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.diags.ToError()
+
+			if got == nil && test.expected == nil {
+				return
+			}
+
+			if got == nil || test.expected == nil || got.Error() != test.expected.Error() {
+				t.Fatalf("expected: %v, got: %v", test.expected, got)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsWarnings(t *testing.T) {
 	t.Parallel()
 
@@ -833,3 +1193,143 @@ func TestDiagnosticsWarnings(t *testing.T) {
 		})
 	}
 }
+
+func TestNewErrorDiagnosticsFromErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		summary  string
+		errs     []error
+		expected diag.Diagnostics
+	}{
+		"nil": {
+			summary:  "Error Summary",
+			errs:     nil,
+			expected: nil,
+		},
+		"all-nil": {
+			summary:  "Error Summary",
+			errs:     []error{nil, nil},
+			expected: nil,
+		},
+		"error": {
+			summary: "Error Summary",
+			errs:    []error{errors.New("error one")},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "error one"),
+			},
+		},
+		"mixed-nil-and-errors": {
+			summary: "Error Summary",
+			errs: []error{
+				nil,
+				errors.New("error one"),
+				nil,
+				errors.New("error two"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "error one"),
+				diag.NewErrorDiagnostic("Error Summary", "error two"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.NewErrorDiagnosticsFromErrors(testCase.summary, testCase.errs...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a        diag.Diagnostics
+		b        diag.Diagnostics
+		expected diag.Diagnostics
+	}{
+		"nil": {
+			a:        nil,
+			b:        nil,
+			expected: nil,
+		},
+		"disjoint": {
+			a: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+			},
+			b: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "three summary", "three detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "three summary", "three detail"),
+			},
+		},
+		"overlapping": {
+			a: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+			},
+			b: diag.Diagnostics{
+				diag.NewErrorDiagnostic("three summary", "three detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+				diag.NewErrorDiagnostic("three summary", "three detail"),
+			},
+		},
+		"overlapping-path": {
+			a: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+			},
+			b: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("other"), "one summary", "one detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("other"), "one summary", "one detail"),
+			},
+		},
+		"duplicates-within": {
+			a: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+			b: diag.Diagnostics{
+				nil,
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.Merge(testCase.a, testCase.b)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
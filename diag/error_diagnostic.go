@@ -44,3 +44,13 @@ func NewErrorDiagnostic(summary string, detail string) ErrorDiagnostic {
 		summary: summary,
 	}
 }
+
+// NewErrorDiagnosticWithCode returns a new error severity diagnostic with the
+// given machine-readable code, summary, and detail. The returned diagnostic
+// implements DiagnosticWithCode. The code is not sent to Terraform.
+func NewErrorDiagnosticWithCode(code string, summary string, detail string) DiagnosticWithCode {
+	return withCode{
+		Diagnostic: NewErrorDiagnostic(summary, detail),
+		code:       code,
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+// DefaultProviderDeveloperReportMessage is the default value of
+// ProviderDeveloperReportMessage.
+const DefaultProviderDeveloperReportMessage = "Please report the following to the provider developer:"
+
+// ProviderDeveloperReportMessage is the sentence in framework error
+// diagnostics, such as value conversion, type validation, and schema data
+// errors, which directs practitioners to report the error details that follow
+// it. Providers can customize it to direct
+// practitioners to their own issue tracker, for example:
+//
+//	diag.ProviderDeveloperReportMessage = "Please report the following at https://github.com/example/terraform-provider-example/issues:"
+//
+// It should only be set before the provider server is started, such as in the
+// provider main function, as it is not safe for concurrent modification.
+var ProviderDeveloperReportMessage = DefaultProviderDeveloperReportMessage
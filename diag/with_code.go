@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+var _ DiagnosticWithCode = withCode{}
+
+// withCode wraps a diagnostic with a machine-readable code.
+type withCode struct {
+	Diagnostic
+
+	code string
+}
+
+// Code returns the machine-readable code.
+func (d withCode) Code() string {
+	return d.code
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d withCode) Equal(other Diagnostic) bool {
+	o, ok := other.(withCode)
+
+	if !ok {
+		return false
+	}
+
+	if d.Code() != o.Code() {
+		return false
+	}
+
+	if d.Diagnostic == nil {
+		return d.Diagnostic == o.Diagnostic
+	}
+
+	return d.Diagnostic.Equal(o.Diagnostic)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestNewErrorDiagnosticWithCode(t *testing.T) {
+	t.Parallel()
+
+	got := diag.NewErrorDiagnosticWithCode("test_code", "test summary", "test detail")
+
+	if got.Code() != "test_code" {
+		t.Errorf("Unexpected code: got: %q, wanted: %q", got.Code(), "test_code")
+	}
+
+	if got.Severity() != diag.SeverityError {
+		t.Errorf("Unexpected severity: got: %s", got.Severity())
+	}
+
+	if got.Summary() != "test summary" {
+		t.Errorf("Unexpected summary: got: %q", got.Summary())
+	}
+
+	if got.Detail() != "test detail" {
+		t.Errorf("Unexpected detail: got: %q", got.Detail())
+	}
+}
+
+func TestDiagnosticWithCodeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.Diagnostic
+		other    diag.Diagnostic
+		expected bool
+	}{
+		"matching": {
+			diag:     diag.NewErrorDiagnosticWithCode("test_code", "test summary", "test detail"),
+			other:    diag.NewErrorDiagnosticWithCode("test_code", "test summary", "test detail"),
+			expected: true,
+		},
+		"different-code": {
+			diag:     diag.NewErrorDiagnosticWithCode("test_code", "test summary", "test detail"),
+			other:    diag.NewErrorDiagnosticWithCode("other_code", "test summary", "test detail"),
+			expected: false,
+		},
+		"different-summary": {
+			diag:     diag.NewErrorDiagnosticWithCode("test_code", "test summary", "test detail"),
+			other:    diag.NewErrorDiagnosticWithCode("test_code", "other summary", "test detail"),
+			expected: false,
+		},
+		"without-code": {
+			diag:     diag.NewErrorDiagnosticWithCode("test_code", "test summary", "test detail"),
+			other:    diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsAppendWithCode(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+
+	diags.Append(
+		diag.NewErrorDiagnosticWithCode("test_code", "test summary", "test detail"),
+		diag.NewErrorDiagnosticWithCode("test_code", "test summary", "test detail"),
+		diag.NewErrorDiagnosticWithCode("other_code", "test summary", "test detail"),
+		diag.NewErrorDiagnostic("test summary", "test detail"),
+	)
+
+	if len(diags) != 3 {
+		t.Fatalf("Unexpected diagnostics count: got: %d, wanted: 3", len(diags))
+	}
+
+	var codes []string
+
+	for _, d := range diags {
+		dWithCode, ok := d.(diag.DiagnosticWithCode)
+
+		if !ok {
+			continue
+		}
+
+		codes = append(codes, dWithCode.Code())
+	}
+
+	if len(codes) != 2 || codes[0] != "test_code" || codes[1] != "other_code" {
+		t.Errorf("Unexpected codes: got: %q, wanted: %q", codes, []string{"test_code", "other_code"})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import (
+	"fmt"
+)
+
+var _ DiagnosticWithPath = withSuggestion{}
+var _ DiagnosticWithSuggestion = withSuggestion{}
+
+// withSuggestion wraps a diagnostic with path information with a suggested
+// correction.
+type withSuggestion struct {
+	DiagnosticWithPath
+
+	suggestion string
+}
+
+// Detail returns the diagnostic detail, followed by the suggestion.
+func (d withSuggestion) Detail() string {
+	return fmt.Sprintf("%s\n\nDid you mean %q?", d.DiagnosticWithPath.Detail(), d.suggestion)
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d withSuggestion) Equal(other Diagnostic) bool {
+	o, ok := other.(withSuggestion)
+
+	if !ok {
+		return false
+	}
+
+	if d.Suggestion() != o.Suggestion() {
+		return false
+	}
+
+	if d.DiagnosticWithPath == nil {
+		return d.DiagnosticWithPath == o.DiagnosticWithPath
+	}
+
+	return d.DiagnosticWithPath.Equal(o.DiagnosticWithPath)
+}
+
+// Suggestion returns the suggested correction.
+func (d withSuggestion) Suggestion() string {
+	return d.suggestion
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestNewAttributeErrorDiagnosticWithSuggestion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		suggestion         string
+		expectedDetail     string
+		expectedSuggestion bool
+	}{
+		"suggestion": {
+			suggestion:         "test",
+			expectedDetail:     "test detail\n\nDid you mean \"test\"?",
+			expectedSuggestion: true,
+		},
+		"empty-suggestion": {
+			suggestion:     "",
+			expectedDetail: "test detail",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.NewAttributeErrorDiagnosticWithSuggestion(path.Root("test"), "test summary", "test detail", tc.suggestion)
+
+			if got.Detail() != tc.expectedDetail {
+				t.Errorf("Unexpected detail: got: %q, wanted: %q", got.Detail(), tc.expectedDetail)
+			}
+
+			if !got.Path().Equal(path.Root("test")) {
+				t.Errorf("Unexpected path: got: %s", got.Path())
+			}
+
+			gotWithSuggestion, ok := got.(diag.DiagnosticWithSuggestion)
+
+			if ok != tc.expectedSuggestion {
+				t.Fatalf("Unexpected DiagnosticWithSuggestion implementation: got: %t, wanted: %t", ok, tc.expectedSuggestion)
+			}
+
+			if ok && gotWithSuggestion.Suggestion() != tc.suggestion {
+				t.Errorf("Unexpected suggestion: got: %q, wanted: %q", gotWithSuggestion.Suggestion(), tc.suggestion)
+			}
+		})
+	}
+}
+
+func TestDiagnosticWithSuggestionEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.Diagnostic
+		other    diag.Diagnostic
+		expected bool
+	}{
+		"matching": {
+			diag:     diag.NewAttributeErrorDiagnosticWithSuggestion(path.Root("test"), "test summary", "test detail", "test"),
+			other:    diag.NewAttributeErrorDiagnosticWithSuggestion(path.Root("test"), "test summary", "test detail", "test"),
+			expected: true,
+		},
+		"different-suggestion": {
+			diag:     diag.NewAttributeErrorDiagnosticWithSuggestion(path.Root("test"), "test summary", "test detail", "test"),
+			other:    diag.NewAttributeErrorDiagnosticWithSuggestion(path.Root("test"), "test summary", "test detail", "other"),
+			expected: false,
+		},
+		"different-path": {
+			diag:     diag.NewAttributeErrorDiagnosticWithSuggestion(path.Root("test"), "test summary", "test detail", "test"),
+			other:    diag.NewAttributeErrorDiagnosticWithSuggestion(path.Root("other"), "test summary", "test detail", "test"),
+			expected: false,
+		},
+		"without-suggestion": {
+			diag:     diag.NewAttributeErrorDiagnosticWithSuggestion(path.Root("test"), "test summary", "test detail", "test"),
+			other:    diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ func ValidateResourceTypeConfigRequest(ctx context.Context, proto5 *tfprotov5.Va
 
 	fw.Config = config
 	fw.Resource = resource
+	fw.TypeName = proto5.TypeName
 
 	return fw, diags
 }
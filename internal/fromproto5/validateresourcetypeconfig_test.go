@@ -88,6 +88,14 @@ func TestValidateResourceTypeConfigRequest(t *testing.T) {
 				},
 			},
 		},
+		"type-name": {
+			input: &tfprotov5.ValidateResourceTypeConfigRequest{
+				TypeName: "test_type",
+			},
+			expected: &fwserver.ValidateResourceConfigRequest{
+				TypeName: "test_type",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
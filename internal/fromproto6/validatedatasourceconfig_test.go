@@ -88,6 +88,14 @@ func TestValidateDataSourceConfigRequest(t *testing.T) {
 				},
 			},
 		},
+		"type-name": {
+			input: &tfprotov6.ValidateDataResourceConfigRequest{
+				TypeName: "test_type",
+			},
+			expected: &fwserver.ValidateDataSourceConfigRequest{
+				TypeName: "test_type",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
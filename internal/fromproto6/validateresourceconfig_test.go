@@ -88,6 +88,14 @@ func TestValidateResourceConfigRequest(t *testing.T) {
 				},
 			},
 		},
+		"type-name": {
+			input: &tfprotov6.ValidateResourceConfigRequest{
+				TypeName: "test_type",
+			},
+			expected: &fwserver.ValidateResourceConfigRequest{
+				TypeName: "test_type",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// AttributeWithEnvironmentVariable is an optional interface on Attribute
+// which enables overriding the configuration value with an environment
+// variable value during provider configuration.
+type AttributeWithEnvironmentVariable interface {
+	Attribute
+
+	// GetEnvironmentVariable should return the name of the environment
+	// variable which overrides the configuration value, if set.
+	GetEnvironmentVariable() string
+}
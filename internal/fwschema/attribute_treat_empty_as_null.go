@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// AttributeWithTreatEmptyAsNull is an optional interface on Attribute which
+// enables treating empty and null collection values as equivalent when
+// saving resource state.
+type AttributeWithTreatEmptyAsNull interface {
+	Attribute
+
+	// GetTreatEmptyAsNull should return true if empty and null values of the
+	// attribute should be considered equivalent. This is named differently
+	// than TreatEmptyAsNull to prevent a conflict with the field name.
+	GetTreatEmptyAsNull() bool
+}
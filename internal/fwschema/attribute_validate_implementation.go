@@ -30,6 +30,8 @@ type AttributeWithValidateImplementation interface {
 //
 // This logic currently:
 //   - Checks whether the given AttributeName in the path is a valid identifier
+//   - Checks whether exactly one of Required, Optional, or Computed is set,
+//     with the exception of Optional and Computed together
 //   - If the given Attribute implements the
 //     AttributeWithValidateImplementation interface, calls the method
 //   - If the given Attribute implements the NestedAttribute interface,
@@ -39,6 +41,13 @@ func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, r
 
 	diags.Append(IsValidAttributeName(req.Name, req.Path)...)
 
+	switch {
+	case attribute.IsRequired() && (attribute.IsOptional() || attribute.IsComputed()):
+		diags.Append(AttributeRequiredWithOptionalOrComputedDiag(req.Path))
+	case !attribute.IsRequired() && !attribute.IsOptional() && !attribute.IsComputed():
+		diags.Append(AttributeMissingConfigurabilityDiag(req.Path))
+	}
+
 	if attributeWithValidateImplementation, ok := attribute.(AttributeWithValidateImplementation); ok {
 		resp := &ValidateImplementationResponse{}
 
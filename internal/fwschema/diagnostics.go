@@ -43,3 +43,37 @@ func AttributeMissingElementTypeDiag(attributePath path.Path) diag.Diagnostic {
 			"One of these fields is required to prevent other unexpected errors or panics.",
 	)
 }
+
+// AttributeMissingConfigurabilityDiag returns an error diagnostic to provider
+// developers about an Attribute implementation which does not set any of the
+// Required, Optional, or Computed fields. This otherwise causes unexpected
+// errors when Terraform or the framework convert values for the attribute.
+func AttributeMissingConfigurabilityDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q must set one of the Required, Optional, or Computed fields to true.", attributePath),
+	)
+}
+
+// AttributeRequiredWithOptionalOrComputedDiag returns an error diagnostic to
+// provider developers about an Attribute implementation which sets the
+// Required field alongside the Optional or Computed fields. This otherwise
+// causes unexpected errors when Terraform or the framework convert values for
+// the attribute.
+func AttributeRequiredWithOptionalOrComputedDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q sets the Required field to true alongside the Optional or Computed fields. ", attributePath)+
+			"Required attributes cannot also be Optional or Computed.",
+	)
+}
@@ -29,7 +29,7 @@ func (d Data) GetAtPath(ctx context.Context, schemaPath path.Path, target any) d
 		diags.AddAttributeError(
 			schemaPath,
 			d.Description.Title()+" Read Error",
-			"An unexpected error was encountered trying to read an attribute from the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to read an attribute from the "+d.Description.String()+". This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				"Missing attribute value, however no error was returned. Preventing the panic from this situation.",
 		)
 		return diags
@@ -5050,6 +5050,80 @@ func TestDataGetAtPath(t *testing.T) {
 				},
 			)),
 		},
+		"SetType-types.Set-AtSetValue": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"set": testschema.Attribute{
+							Optional: true,
+							Type: types.SetType{
+								ElemType: types.StringType,
+							},
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"set": tftypes.Set{
+								ElementType: tftypes.String,
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"set": tftypes.NewValue(
+							tftypes.Set{
+								ElementType: tftypes.String,
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(tftypes.String, "test1"),
+								tftypes.NewValue(tftypes.String, "test2"),
+							},
+						),
+					},
+				),
+			},
+			path:     path.Root("set").AtSetValue(types.StringValue("test2")),
+			target:   new(types.String),
+			expected: pointer(types.StringValue("test2")),
+		},
+		"SetType-types.Set-AtSetValue-missing": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"set": testschema.Attribute{
+							Optional: true,
+							Type: types.SetType{
+								ElemType: types.StringType,
+							},
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"set": tftypes.Set{
+								ElementType: tftypes.String,
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"set": tftypes.NewValue(
+							tftypes.Set{
+								ElementType: tftypes.String,
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(tftypes.String, "test1"),
+								tftypes.NewValue(tftypes.String, "test2"),
+							},
+						),
+					},
+				),
+			},
+			path:     path.Root("set").AtSetValue(types.StringValue("test3")),
+			target:   new(types.String),
+			expected: pointer(types.StringNull()),
+		},
 		"SetType-[]types.String-null": {
 			data: fwschemadata.Data{
 				Schema: testschema.Schema{
@@ -37,7 +37,7 @@ func (d Data) PathExists(ctx context.Context, path path.Path) (bool, diag.Diagno
 		diags.AddAttributeError(
 			path,
 			d.Description.Title()+" Read Error",
-			"An unexpected error was encountered trying to read an attribute from the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to read an attribute from the "+d.Description.String()+". This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				fmt.Sprintf("Cannot walk attribute path in %s: %s", d.Description, err),
 		)
 		return false, diags
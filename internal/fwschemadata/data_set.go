@@ -14,8 +14,9 @@ import (
 
 // Set replaces the entire value. The value should be a struct whose fields
 // have one of the attr.Value types. Each field must have the tfsdk field tag.
-func (d *Data) Set(ctx context.Context, val any) diag.Diagnostics {
-	attrValue, diags := reflect.FromValue(ctx, d.Schema.Type(), val, path.Empty())
+// The reflection options control how Go values are converted.
+func (d *Data) Set(ctx context.Context, val any, opts reflect.Options) diag.Diagnostics {
+	attrValue, diags := reflect.FromValue(ctx, d.Schema.Type(), val, opts, path.Empty())
 
 	if diags.HasError() {
 		return diags
@@ -26,7 +27,7 @@ func (d *Data) Set(ctx context.Context, val any) diag.Diagnostics {
 	if err != nil {
 		diags.AddError(
 			d.Description.Title()+" Write Error",
-			"An unexpected error was encountered trying to write the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to write the "+d.Description.String()+". This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				fmt.Sprintf("Error: Unable to run ToTerraformValue on new value: %s", err),
 		)
 		return diags
@@ -25,7 +25,7 @@ import (
 // paths as necessary.
 //
 // Lists can only have the next element added according to the current length.
-func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
+func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}, opts reflect.Options) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
@@ -44,13 +44,13 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 		diags.AddAttributeError(
 			path,
 			d.Description.Title()+" Write Error",
-			"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				"Error: "+err.Error(),
 		)
 		return diags
 	}
 
-	newVal, newValDiags := reflect.FromValue(ctx, attrType, val, path)
+	newVal, newValDiags := reflect.FromValue(ctx, attrType, val, opts, path)
 	diags.Append(newValDiags...)
 
 	if diags.HasError() {
@@ -63,7 +63,7 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 		diags.AddAttributeError(
 			path,
 			d.Description.Title()+" Write Error",
-			"An unexpected error was encountered trying to write an attribute to the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to write an attribute to the "+d.Description.String()+". This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				"Error: Cannot run ToTerraformValue on new data value: "+err.Error(),
 		)
 		return diags
@@ -93,7 +93,7 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 		diags.AddAttributeError(
 			path,
 			d.Description.Title()+" Write Error",
-			"An unexpected error was encountered trying to write an attribute to the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to write an attribute to the "+d.Description.String()+". This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				"Error: Cannot transform data: "+err.Error(),
 		)
 		return diags
@@ -141,7 +141,7 @@ func (d Data) SetAtPathTransformFunc(ctx context.Context, path path.Path, tfVal
 		diags.AddAttributeError(
 			parentPath,
 			d.Description.Title()+" Write Error",
-			"An unexpected error was encountered trying to write an attribute to the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to write an attribute to the "+d.Description.String()+". This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return nil, diags
 	}
@@ -152,7 +152,7 @@ func (d Data) SetAtPathTransformFunc(ctx context.Context, path path.Path, tfVal
 		diags.AddAttributeError(
 			parentPath,
 			d.Description.Title()+" Read Error",
-			"An unexpected error was encountered trying to read an attribute from the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to read an attribute from the "+d.Description.String()+". This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return nil, diags
 	}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SetUnknownAtPath sets the attribute at `path` to an unknown value of the
+// attribute type.
+//
+// The attribute path must be valid with the current schema and must refer to
+// a computed attribute. Paths to blocks or to positions within attributes,
+// such as collection elements, are not supported.
+func (d *Data) SetUnknownAtPath(ctx context.Context, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
+
+	attribute, attributeDiags := d.Schema.AttributeAtPath(ctx, path)
+
+	diags.Append(attributeDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if !attribute.IsComputed() {
+		diags.AddAttributeError(
+			path,
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to write an unknown value to the "+d.Description.String()+". This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				"Error: Unknown values can only be set on computed attributes.",
+		)
+		return diags
+	}
+
+	attrType := attribute.GetType()
+
+	unknownValue, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), tftypes.UnknownValue))
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to write an unknown value to the "+d.Description.String()+". This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				"Error: Cannot create unknown value: "+err.Error(),
+		)
+		return diags
+	}
+
+	diags.Append(d.SetAtPath(ctx, path, unknownValue, reflect.Options{})...)
+
+	return diags
+}
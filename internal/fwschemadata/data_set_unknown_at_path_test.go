@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDataSetUnknownAtPath(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed": tftypes.String,
+			"computed_list": tftypes.List{
+				ElementType: tftypes.String,
+			},
+			"block": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested_computed": tftypes.String,
+				},
+			},
+			"required": tftypes.String,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"computed": testschema.Attribute{
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"computed_list": testschema.Attribute{
+				Computed: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+			"required": testschema.Attribute{
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+		Blocks: map[string]fwschema.Block{
+			"block": testschema.Block{
+				NestedObject: testschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested_computed": testschema.Attribute{
+							Computed: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.BlockNestingModeSingle,
+			},
+		},
+	}
+
+	testValue := func(computed, computedList, nestedComputed tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"computed":      computed,
+			"computed_list": computedList,
+			"block": tftypes.NewValue(testType.AttributeTypes["block"], map[string]tftypes.Value{
+				"nested_computed": nestedComputed,
+			}),
+			"required": tftypes.NewValue(tftypes.String, "should be untouched"),
+		})
+	}
+
+	testComputedList := tftypes.NewValue(
+		tftypes.List{ElementType: tftypes.String},
+		[]tftypes.Value{
+			tftypes.NewValue(tftypes.String, "element"),
+		},
+	)
+
+	testCases := map[string]struct {
+		data          fwschemadata.Data
+		path          path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"computed": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: testValue(
+					tftypes.NewValue(tftypes.String, "value"),
+					testComputedList,
+					tftypes.NewValue(tftypes.String, "nested"),
+				),
+			},
+			path: path.Root("computed"),
+			expected: testValue(
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				testComputedList,
+				tftypes.NewValue(tftypes.String, "nested"),
+			),
+		},
+		"computed-collection": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: testValue(
+					tftypes.NewValue(tftypes.String, "value"),
+					testComputedList,
+					tftypes.NewValue(tftypes.String, "nested"),
+				),
+			},
+			path: path.Root("computed_list"),
+			expected: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+				tftypes.NewValue(tftypes.String, "nested"),
+			),
+		},
+		"non-computed": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: testValue(
+					tftypes.NewValue(tftypes.String, "value"),
+					testComputedList,
+					tftypes.NewValue(tftypes.String, "nested"),
+				),
+			},
+			path: path.Root("required"),
+			expected: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				testComputedList,
+				tftypes.NewValue(tftypes.String, "nested"),
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("required"),
+					"Plan Write Error",
+					"An unexpected error was encountered trying to write an unknown value to the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: Unknown values can only be set on computed attributes.",
+				),
+			},
+		},
+		"block": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: testValue(
+					tftypes.NewValue(tftypes.String, "value"),
+					testComputedList,
+					tftypes.NewValue(tftypes.String, "nested"),
+				),
+			},
+			path: path.Root("block"),
+			expected: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				testComputedList,
+				tftypes.NewValue(tftypes.String, "nested"),
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("block"),
+					"Invalid Schema Path",
+					"When attempting to get the framework attribute associated with a schema path, an unexpected error was returned. "+
+						"This is always an issue with the provider. Please report this to the provider developers.\n\n"+
+						"Path: block\n"+
+						"Original Error: "+fwschema.ErrPathIsBlock.Error(),
+				),
+			},
+		},
+		"collection-element": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: testValue(
+					tftypes.NewValue(tftypes.String, "value"),
+					testComputedList,
+					tftypes.NewValue(tftypes.String, "nested"),
+				),
+			},
+			path: path.Root("computed_list").AtListIndex(0),
+			expected: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				testComputedList,
+				tftypes.NewValue(tftypes.String, "nested"),
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("computed_list").AtListIndex(0),
+					"Invalid Schema Path",
+					"When attempting to get the framework attribute associated with a schema path, an unexpected error was returned. "+
+						"This is always an issue with the provider. Please report this to the provider developers.\n\n"+
+						"Path: computed_list[0]\n"+
+						"Original Error: "+fwschema.ErrPathInsideAtomicAttribute.Error(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.data.SetUnknownAtPath(context.Background(), testCase.path)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.data.TerraformValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// TransformTreatEmptyAsNull walks the schema and, for attributes which treat
+// empty and null values as equivalent, converts empty collection values to
+// null when referenceRaw contains a null value at the same path, or null
+// values to empty collection values when referenceRaw contains an empty
+// collection value at the same path. This preserves the reference data
+// representation, such as the plan or prior state, when the provider returns
+// the other equivalent representation.
+func (d *Data) TransformTreatEmptyAsNull(ctx context.Context, referenceRaw tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	transformedValue, err := tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
+		// Do not transform if value is not a collection.
+		switch tfTypeValue.Type().(type) {
+		case tftypes.List, tftypes.Map, tftypes.Set:
+		default:
+			return tfTypeValue, nil
+		}
+
+		// Do not transform if value is not null and not empty.
+		if !tfTypeValue.IsNull() && !tftypesValueIsEmptyCollection(tfTypeValue) {
+			return tfTypeValue, nil
+		}
+
+		attribute, err := d.Schema.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		// Do not transform if path is not an attribute, such as a block or
+		// an element inside an attribute without its own schema.
+		if err != nil {
+			return tfTypeValue, nil //nolint:nilerr // Non-attribute paths are not transformed.
+		}
+
+		attributeWithTreatEmptyAsNull, ok := attribute.(fwschema.AttributeWithTreatEmptyAsNull)
+
+		if !ok || !attributeWithTreatEmptyAsNull.GetTreatEmptyAsNull() {
+			return tfTypeValue, nil
+		}
+
+		rawReferenceValue, _, err := tftypes.WalkAttributePath(referenceRaw, tfTypePath)
+
+		// Do not transform if the path does not exist in the reference data,
+		// such as a new collection element.
+		if err != nil {
+			return tfTypeValue, nil //nolint:nilerr // Missing reference paths are not transformed.
+		}
+
+		referenceValue, ok := rawReferenceValue.(tftypes.Value)
+
+		if !ok {
+			return tfTypeValue, nil
+		}
+
+		switch {
+		case tfTypeValue.IsNull() && tftypesValueIsEmptyCollection(referenceValue):
+			logging.FrameworkTrace(ctx, "Transforming null value to empty value due to TreatEmptyAsNull", map[string]any{
+				logging.KeyAttributePath: tfTypePath.String(),
+				logging.KeyDescription:   d.Description.String(),
+			})
+
+			return referenceValue, nil
+		case !tfTypeValue.IsNull() && referenceValue.IsNull():
+			logging.FrameworkTrace(ctx, "Transforming empty value to null value due to TreatEmptyAsNull", map[string]any{
+				logging.KeyAttributePath: tfTypePath.String(),
+				logging.KeyDescription:   d.Description.String(),
+			})
+
+			return tftypes.NewValue(tfTypeValue.Type(), nil), nil
+		}
+
+		return tfTypeValue, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			d.Description.Title()+" Data Transformation Error",
+			"An unexpected error occurred while transforming "+d.Description.String()+" data. "+
+				"This is always an issue with terraform-plugin-framework and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	d.TerraformValue = transformedValue
+
+	return diags
+}
+
+// tftypesValueIsEmptyCollection returns true if the given value is a known
+// list, map, or set value without any elements.
+func tftypesValueIsEmptyCollection(value tftypes.Value) bool {
+	if value.IsNull() || !value.IsKnown() {
+		return false
+	}
+
+	switch value.Type().(type) {
+	case tftypes.List, tftypes.Set:
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return false
+		}
+
+		return len(elements) == 0
+	case tftypes.Map:
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return false
+		}
+
+		return len(elements) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataTransformTreatEmptyAsNull(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"list_disabled": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"list_enabled": schema.ListAttribute{
+				ElementType:      types.StringType,
+				Optional:         true,
+				TreatEmptyAsNull: true,
+			},
+			"map_enabled": schema.MapAttribute{
+				ElementType:      types.StringType,
+				Optional:         true,
+				TreatEmptyAsNull: true,
+			},
+			"set_enabled": schema.SetAttribute{
+				ElementType:      types.StringType,
+				Optional:         true,
+				TreatEmptyAsNull: true,
+			},
+		},
+	}
+	testListType := tftypes.List{ElementType: tftypes.String}
+	testMapType := tftypes.Map{ElementType: tftypes.String}
+	testSetType := tftypes.Set{ElementType: tftypes.String}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list_disabled": testListType,
+			"list_enabled":  testListType,
+			"map_enabled":   testMapType,
+			"set_enabled":   testSetType,
+		},
+	}
+	testEmptyValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"list_disabled": tftypes.NewValue(testListType, []tftypes.Value{}),
+		"list_enabled":  tftypes.NewValue(testListType, []tftypes.Value{}),
+		"map_enabled":   tftypes.NewValue(testMapType, map[string]tftypes.Value{}),
+		"set_enabled":   tftypes.NewValue(testSetType, []tftypes.Value{}),
+	})
+	testNullValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"list_disabled": tftypes.NewValue(testListType, nil),
+		"list_enabled":  tftypes.NewValue(testListType, nil),
+		"map_enabled":   tftypes.NewValue(testMapType, nil),
+		"set_enabled":   tftypes.NewValue(testSetType, nil),
+	})
+	testKnownValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"list_disabled": tftypes.NewValue(testListType, []tftypes.Value{tftypes.NewValue(tftypes.String, "test")}),
+		"list_enabled":  tftypes.NewValue(testListType, []tftypes.Value{tftypes.NewValue(tftypes.String, "test")}),
+		"map_enabled":   tftypes.NewValue(testMapType, map[string]tftypes.Value{"test": tftypes.NewValue(tftypes.String, "test")}),
+		"set_enabled":   tftypes.NewValue(testSetType, []tftypes.Value{tftypes.NewValue(tftypes.String, "test")}),
+	})
+
+	testCases := map[string]struct {
+		terraformValue tftypes.Value
+		referenceValue tftypes.Value
+		expected       tftypes.Value
+		expectedDiags  diag.Diagnostics
+	}{
+		"empty-reference-empty": {
+			terraformValue: testEmptyValue,
+			referenceValue: testEmptyValue,
+			expected:       testEmptyValue,
+		},
+		"empty-reference-known": {
+			terraformValue: testEmptyValue,
+			referenceValue: testKnownValue,
+			expected:       testEmptyValue,
+		},
+		"empty-reference-null": {
+			terraformValue: testEmptyValue,
+			referenceValue: testNullValue,
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"list_disabled": tftypes.NewValue(testListType, []tftypes.Value{}),
+				"list_enabled":  tftypes.NewValue(testListType, nil),
+				"map_enabled":   tftypes.NewValue(testMapType, nil),
+				"set_enabled":   tftypes.NewValue(testSetType, nil),
+			}),
+		},
+		"known-reference-null": {
+			terraformValue: testKnownValue,
+			referenceValue: testNullValue,
+			expected:       testKnownValue,
+		},
+		"null-reference-empty": {
+			terraformValue: testNullValue,
+			referenceValue: testEmptyValue,
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"list_disabled": tftypes.NewValue(testListType, nil),
+				"list_enabled":  tftypes.NewValue(testListType, []tftypes.Value{}),
+				"map_enabled":   tftypes.NewValue(testMapType, map[string]tftypes.Value{}),
+				"set_enabled":   tftypes.NewValue(testSetType, []tftypes.Value{}),
+			}),
+		},
+		"null-reference-known": {
+			terraformValue: testNullValue,
+			referenceValue: testKnownValue,
+			expected:       testNullValue,
+		},
+		"null-reference-null": {
+			terraformValue: testNullValue,
+			referenceValue: testNullValue,
+			expected:       testNullValue,
+		},
+		"reference-missing": {
+			terraformValue: testEmptyValue,
+			referenceValue: tftypes.NewValue(testType, nil),
+			expected:       testEmptyValue,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: testCase.terraformValue,
+			}
+
+			diags := data.TransformTreatEmptyAsNull(context.Background(), testCase.referenceValue)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(data.TerraformValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
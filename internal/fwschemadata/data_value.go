@@ -36,7 +36,7 @@ func (d Data) ValueAtPath(ctx context.Context, schemaPath path.Path) (attr.Value
 		diags.AddAttributeError(
 			schemaPath,
 			d.Description.Title()+" Read Error",
-			"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				"Error: "+err.Error(),
 		)
 		return nil, diags
@@ -51,7 +51,7 @@ func (d Data) ValueAtPath(ctx context.Context, schemaPath path.Path) (attr.Value
 				schemaPath,
 				d.Description.Title()+" Read Error",
 				"An unexpected error was encountered trying to create a null attribute value from the given path. "+
-					"Please report the following to the provider developer:\n\n"+
+					diag.ProviderDeveloperReportMessage+"\n\n"+
 					"Type: "+attrType.String()+"\n"+
 					"Error:"+err.Error(),
 			)
@@ -67,7 +67,7 @@ func (d Data) ValueAtPath(ctx context.Context, schemaPath path.Path) (attr.Value
 		diags.AddAttributeError(
 			schemaPath,
 			d.Description.Title()+" Read Error",
-			"An unexpected error was encountered trying to retrieve an attribute value from the given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to retrieve an attribute value from the given path. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return nil, diags
 	}
@@ -93,7 +93,7 @@ func (d Data) ValueAtPath(ctx context.Context, schemaPath path.Path) (attr.Value
 		diags.AddAttributeError(
 			schemaPath,
 			d.Description.Title()+" Read Error",
-			"An unexpected error was encountered trying to convert an attribute value from the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to convert an attribute value from the "+d.Description.String()+". This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				"Error: "+err.Error(),
 		)
 		return nil, diags
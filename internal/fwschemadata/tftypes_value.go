@@ -48,7 +48,7 @@ func CreateParentTerraformValue(_ context.Context, parentPath path.Path, parentT
 		diags.AddAttributeError(
 			parentPath,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to create a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				fmt.Sprintf("Unknown parent type %s to create value.", parentType),
 		)
 		return parentValue, diags
@@ -74,7 +74,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 			diags.AddAttributeError(
 				parentPath,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"An unexpected error was encountered trying to create a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 					fmt.Sprintf("Cannot add attribute into parent type: %s", parentValue.Type()),
 			)
 			return parentValue, diags
@@ -87,7 +87,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 			diags.AddAttributeError(
 				parentPath,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"An unexpected error was encountered trying to create a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 					fmt.Sprintf("Unable to extract object elements from parent value: %s", err),
 			)
 			return parentValue, diags
@@ -101,7 +101,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 			diags.AddAttributeError(
 				parentPath,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"An unexpected error was encountered trying to create a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 					fmt.Sprintf("Cannot add list element into parent type: %s", parentValue.Type()),
 			)
 			return parentValue, diags
@@ -114,7 +114,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 			diags.AddAttributeError(
 				parentPath,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"An unexpected error was encountered trying to create a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 					fmt.Sprintf("Unable to extract list elements from parent value: %s", err),
 			)
 			return parentValue, diags
@@ -124,7 +124,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 			diags.AddAttributeError(
 				parentPath,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"An unexpected error was encountered trying to create a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 					fmt.Sprintf("Cannot add list element %d as list currently has %d length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.", int(childStep)+1, len(parentElems)),
 			)
 			return parentValue, diags
@@ -143,7 +143,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 			diags.AddAttributeError(
 				parentPath,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"An unexpected error was encountered trying to create a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 					fmt.Sprintf("Cannot add map value into parent type: %s", parentValue.Type()),
 			)
 			return parentValue, diags
@@ -156,7 +156,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 			diags.AddAttributeError(
 				parentPath,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"An unexpected error was encountered trying to create a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 					fmt.Sprintf("Unable to extract map elements from parent value: %s", err),
 			)
 			return parentValue, diags
@@ -170,7 +170,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 			diags.AddAttributeError(
 				parentPath,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"An unexpected error was encountered trying to create a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 					fmt.Sprintf("Cannot add set element into parent type: %s", parentValue.Type()),
 			)
 			return parentValue, diags
@@ -183,7 +183,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 			diags.AddAttributeError(
 				parentPath,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"An unexpected error was encountered trying to create a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 					fmt.Sprintf("Unable to extract set elements from parent value: %s", err),
 			)
 			return parentValue, diags
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// attributeConfigValue returns the configuration value for an attribute or
+// block validation request, along with its Terraform value when known. If the
+// request AttributeConfig was set from the parent value, it is returned after
+// performing the same type validation as reading the value from the
+// configuration, which prevents converting the value and all of its
+// underlying values again. Type validation receives the Terraform value
+// threaded from the parent value, rather than walking the configuration from
+// its root. Otherwise, the value is read from the configuration.
+func attributeConfigValue(ctx context.Context, attrType attr.Type, req ValidateAttributeRequest) (attr.Value, tftypes.Value, diag.Diagnostics) {
+	configData := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionConfiguration,
+		Schema:         req.Config.Schema,
+		TerraformValue: req.Config.Raw,
+	}
+
+	if req.AttributeConfig == nil {
+		value, diags := configData.ValueAtPath(ctx, req.AttributePath)
+
+		return value, tftypes.Value{}, diags
+	}
+
+	attrTypeWithValidate, ok := attrType.(xattr.TypeWithValidate)
+
+	if !ok {
+		return req.AttributeConfig, req.attributeConfigTerraformValue, nil
+	}
+
+	tfValue := req.attributeConfigTerraformValue
+
+	// The parent Terraform value is not available for set elements, so
+	// convert the value once and thread the result to any nested values.
+	if tfValue.Type() == nil {
+		var err error
+
+		tfValue, err = req.AttributeConfig.ToTerraformValue(ctx)
+
+		// Fall back to reading the value from the configuration, which
+		// handles any conversion errors.
+		if err != nil {
+			value, diags := configData.ValueAtPath(ctx, req.AttributePath)
+
+			return value, tftypes.Value{}, diags
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	logging.FrameworkTrace(ctx, "Type implements TypeWithValidate")
+	logging.FrameworkDebug(ctx, "Calling provider defined Type Validate")
+	diags.Append(attrTypeWithValidate.Validate(ctx, tfValue, req.AttributePath)...)
+	logging.FrameworkDebug(ctx, "Called provider defined Type Validate")
+
+	if diags.HasError() {
+		return nil, tftypes.Value{}, diags
+	}
+
+	return req.AttributeConfig, tfValue, diags
+}
+
+// objectAttributeValues returns the attribute values of a known object value,
+// which are used as the configuration values for nested attribute and block
+// validation. Nil is returned for null, unknown, or non-object values, so
+// nested values are instead read from the configuration.
+func objectAttributeValues(ctx context.Context, value attr.Value) map[string]attr.Value {
+	objectValuable, ok := value.(basetypes.ObjectValuable)
+
+	if !ok || objectValuable.IsNull() || objectValuable.IsUnknown() {
+		return nil
+	}
+
+	objectValue, diags := objectValuable.ToObjectValue(ctx)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	return objectValue.Attributes()
+}
+
+// configAttributeValues returns the root attribute and block values of the
+// configuration, which are used as the configuration values for root
+// attribute and block validation. The configuration is only converted once,
+// rather than once per root attribute and block. Nil is returned if the
+// configuration cannot be converted, so root values are instead read from
+// the configuration, which reports any errors for each value.
+func configAttributeValues(ctx context.Context, config tfsdk.Config) map[string]attr.Value {
+	if config.Schema == nil || config.Raw.Type() == nil {
+		return nil
+	}
+
+	value, err := config.Schema.Type().ValueFromTerraform(ctx, config.Raw)
+
+	if err != nil {
+		return nil
+	}
+
+	return objectAttributeValues(ctx, value)
+}
+
+// listTerraformValues returns the element values of a known list Terraform
+// value, which are threaded to nested attribute and block validation. Nil is
+// returned for null, unknown, or non-list values.
+func listTerraformValues(value tftypes.Value) []tftypes.Value {
+	if value.Type() == nil || !value.Type().Is(tftypes.List{}) || !value.IsKnown() || value.IsNull() {
+		return nil
+	}
+
+	var elements []tftypes.Value
+
+	if err := value.As(&elements); err != nil {
+		return nil
+	}
+
+	return elements
+}
+
+// listTerraformValueAt returns the element value at the given index, or the
+// zero value if the index is outside of the elements.
+func listTerraformValueAt(elements []tftypes.Value, index int) tftypes.Value {
+	if index >= len(elements) {
+		return tftypes.Value{}
+	}
+
+	return elements[index]
+}
+
+// mapTerraformValues returns the element or attribute values of a known map
+// or object Terraform value, which are threaded to nested attribute and block
+// validation. Nil is returned for null, unknown, or other values.
+func mapTerraformValues(value tftypes.Value) map[string]tftypes.Value {
+	if value.Type() == nil || !value.IsKnown() || value.IsNull() {
+		return nil
+	}
+
+	if !value.Type().Is(tftypes.Map{}) && !value.Type().Is(tftypes.Object{}) {
+		return nil
+	}
+
+	var elements map[string]tftypes.Value
+
+	if err := value.As(&elements); err != nil {
+		return nil
+	}
+
+	return elements
+}
@@ -1042,6 +1042,9 @@ func TestAttributeModifyPlan(t *testing.T) {
 				),
 			},
 		},
+		// Set elements are stored in a canonical order, so the planned elements
+		// are ordered by nested_required while the prior state elements are
+		// ordered by nested_computed, which misaligns them.
 		"attribute-set-nested-nested-usestateforunknown-elements-rearranged": {
 			attribute: testschema.NestedAttribute{
 				NestedObject: testschema.NestedAttributeObject{
@@ -1077,7 +1080,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 							},
 							map[string]attr.Value{
 								"nested_computed": types.StringNull(),
-								"nested_required": types.StringValue("testvalue2"), // prior state on index 0 is testvalue1
+								"nested_required": types.StringValue("testvalue2"),
 							},
 						),
 						types.ObjectValueMust(
@@ -1087,7 +1090,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 							},
 							map[string]attr.Value{
 								"nested_computed": types.StringNull(),
-								"nested_required": types.StringValue("testvalue1"), // prior state on index 1 is testvalue2
+								"nested_required": types.StringValue("testvalue1"),
 							},
 						),
 					},
@@ -1138,7 +1141,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 							},
 							map[string]attr.Value{
 								"nested_computed": types.StringValue("statevalue1"),
-								"nested_required": types.StringValue("testvalue1"),
+								"nested_required": types.StringValue("testvalue2"),
 							},
 						),
 						types.ObjectValueMust(
@@ -1148,7 +1151,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 							},
 							map[string]attr.Value{
 								"nested_computed": types.StringValue("statevalue2"),
-								"nested_required": types.StringValue("testvalue2"),
+								"nested_required": types.StringValue("testvalue1"),
 							},
 						),
 					},
@@ -1172,7 +1175,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 								// TODO: Rework list/set element alignment during plan
 								// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/709
 								"nested_computed": types.StringValue("statevalue1"),
-								"nested_required": types.StringValue("testvalue2"),
+								"nested_required": types.StringValue("testvalue1"),
 							},
 						),
 						types.ObjectValueMust(
@@ -1184,7 +1187,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 								// TODO: Rework list/set element alignment during plan
 								// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/709
 								"nested_computed": types.StringValue("statevalue2"),
-								"nested_required": types.StringValue("testvalue1"),
+								"nested_required": types.StringValue("testvalue2"),
 							},
 						),
 					},
@@ -8438,6 +8441,70 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 		},
+		"response-diagnostics-error-remaining-planmodifiers": {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.PlanValue = types.StringValue("unexpected")
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("testvalue"),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"response-planvalue-multiple-planmodifiers": {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.PlanValue = types.StringValue(req.PlanValue.ValueString() + "-first")
+						},
+					},
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.PlanValue = types.StringValue(req.PlanValue.ValueString() + "-second")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringNull(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue-first-second"),
+			},
+		},
 		"response-planvalue": {
 			attribute: testschema.AttributeWithStringPlanModifiers{
 				PlanModifiers: []planmodifier.String{
@@ -7,11 +7,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
-	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -30,10 +31,26 @@ type ValidateAttributeRequest struct {
 	AttributePathExpression path.Expression
 
 	// AttributeConfig contains the value of the attribute in the configuration.
+	// Nested attribute and block validation sets this from the parent value
+	// to prevent walking the configuration from its root for every attribute.
+	// If nil, AttributeValidate and BlockValidate read the value from Config.
 	AttributeConfig attr.Value
 
 	// Config contains the entire configuration of the data source, provider, or resource.
 	Config tfsdk.Config
+
+	// SkipUnknownValues prevents attribute and block validators from being
+	// called with unknown configuration values.
+	SkipUnknownValues bool
+
+	// TypeName is the type name of the data source or resource. It is empty
+	// for provider configuration.
+	TypeName string
+
+	// attributeConfigTerraformValue contains the Terraform value of
+	// AttributeConfig, when threaded from the parent value, which is used
+	// for type validation instead of walking the configuration from its root.
+	attributeConfigTerraformValue tftypes.Value
 }
 
 // ValidateAttributeResponse represents a response to a
@@ -65,13 +82,7 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 		return
 	}
 
-	configData := &fwschemadata.Data{
-		Description:    fwschemadata.DataDescriptionConfiguration,
-		Schema:         req.Config.Schema,
-		TerraformValue: req.Config.Raw,
-	}
-
-	attributeConfig, diags := configData.ValueAtPath(ctx, req.AttributePath)
+	attributeConfig, attributeConfigTerraformValue, diags := attributeConfigValue(ctx, a.GetType(), req)
 	resp.Diagnostics.Append(diags...)
 
 	if diags.HasError() {
@@ -107,6 +118,7 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 	}
 
 	req.AttributeConfig = attributeConfig
+	req.attributeConfigTerraformValue = attributeConfigTerraformValue
 
 	switch attributeWithValidators := a.(type) {
 	case fwxschema.AttributeWithBoolValidators:
@@ -174,12 +186,25 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 
 	validateReq := validator.BoolRequest{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, attributeValidator := range attribute.BoolValidators() {
+		if validatorSkipApplyOnly(ctx, attributeValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.BoolResponse{}
@@ -203,6 +228,12 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -239,12 +270,25 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 
 	validateReq := validator.Float64Request{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, attributeValidator := range attribute.Float64Validators() {
+		if validatorSkipApplyOnly(ctx, attributeValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.Float64Response{}
@@ -268,6 +312,12 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -304,12 +354,25 @@ func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWi
 
 	validateReq := validator.Int64Request{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, attributeValidator := range attribute.Int64Validators() {
+		if validatorSkipApplyOnly(ctx, attributeValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.Int64Response{}
@@ -333,6 +396,12 @@ func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWi
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -369,12 +438,25 @@ func AttributeValidateList(ctx context.Context, attribute fwxschema.AttributeWit
 
 	validateReq := validator.ListRequest{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, attributeValidator := range attribute.ListValidators() {
+		if validatorSkipApplyOnly(ctx, attributeValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.ListResponse{}
@@ -398,6 +480,12 @@ func AttributeValidateList(ctx context.Context, attribute fwxschema.AttributeWit
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -434,12 +522,25 @@ func AttributeValidateMap(ctx context.Context, attribute fwxschema.AttributeWith
 
 	validateReq := validator.MapRequest{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, attributeValidator := range attribute.MapValidators() {
+		if validatorSkipApplyOnly(ctx, attributeValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.MapResponse{}
@@ -463,6 +564,12 @@ func AttributeValidateMap(ctx context.Context, attribute fwxschema.AttributeWith
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -499,12 +606,25 @@ func AttributeValidateNumber(ctx context.Context, attribute fwxschema.AttributeW
 
 	validateReq := validator.NumberRequest{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, attributeValidator := range attribute.NumberValidators() {
+		if validatorSkipApplyOnly(ctx, attributeValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.NumberResponse{}
@@ -528,6 +648,12 @@ func AttributeValidateNumber(ctx context.Context, attribute fwxschema.AttributeW
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -564,12 +690,25 @@ func AttributeValidateObject(ctx context.Context, attribute fwxschema.AttributeW
 
 	validateReq := validator.ObjectRequest{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, attributeValidator := range attribute.ObjectValidators() {
+		if validatorSkipApplyOnly(ctx, attributeValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.ObjectResponse{}
@@ -593,6 +732,12 @@ func AttributeValidateObject(ctx context.Context, attribute fwxschema.AttributeW
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -629,12 +774,25 @@ func AttributeValidateSet(ctx context.Context, attribute fwxschema.AttributeWith
 
 	validateReq := validator.SetRequest{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, attributeValidator := range attribute.SetValidators() {
+		if validatorSkipApplyOnly(ctx, attributeValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.SetResponse{}
@@ -658,6 +816,12 @@ func AttributeValidateSet(ctx context.Context, attribute fwxschema.AttributeWith
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -694,12 +858,25 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 
 	validateReq := validator.StringRequest{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, attributeValidator := range attribute.StringValidators() {
+		if validatorSkipApplyOnly(ctx, attributeValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.StringResponse{}
@@ -723,6 +900,12 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -764,12 +947,17 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			return
 		}
 
+		elementTerraformValues := listTerraformValues(req.attributeConfigTerraformValue)
+
 		for idx, value := range l.Elements() {
 			nestedAttributeObjectReq := ValidateAttributeRequest{
-				AttributeConfig:         value,
-				AttributePath:           req.AttributePath.AtListIndex(idx),
-				AttributePathExpression: req.AttributePathExpression.AtListIndex(idx),
-				Config:                  req.Config,
+				AttributeConfig:               value,
+				attributeConfigTerraformValue: listTerraformValueAt(elementTerraformValues, idx),
+				AttributePath:                 req.AttributePath.AtListIndex(idx),
+				AttributePathExpression:       req.AttributePathExpression.AtListIndex(idx),
+				Config:                        req.Config,
+				SkipUnknownValues:             req.SkipUnknownValues,
+				TypeName:                      req.TypeName,
 			}
 			nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
@@ -804,6 +992,8 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 				AttributePath:           req.AttributePath.AtSetValue(value),
 				AttributePathExpression: req.AttributePathExpression.AtSetValue(value),
 				Config:                  req.Config,
+				SkipUnknownValues:       req.SkipUnknownValues,
+				TypeName:                req.TypeName,
 			}
 			nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
@@ -832,12 +1022,17 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			return
 		}
 
+		elementTerraformValues := mapTerraformValues(req.attributeConfigTerraformValue)
+
 		for key, value := range m.Elements() {
 			nestedAttributeObjectReq := ValidateAttributeRequest{
-				AttributeConfig:         value,
-				AttributePath:           req.AttributePath.AtMapKey(key),
-				AttributePathExpression: req.AttributePathExpression.AtMapKey(key),
-				Config:                  req.Config,
+				AttributeConfig:               value,
+				attributeConfigTerraformValue: elementTerraformValues[key],
+				AttributePath:                 req.AttributePath.AtMapKey(key),
+				AttributePathExpression:       req.AttributePathExpression.AtMapKey(key),
+				Config:                        req.Config,
+				SkipUnknownValues:             req.SkipUnknownValues,
+				TypeName:                      req.TypeName,
 			}
 			nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
@@ -871,10 +1066,13 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 		}
 
 		nestedAttributeObjectReq := ValidateAttributeRequest{
-			AttributeConfig:         o,
-			AttributePath:           req.AttributePath,
-			AttributePathExpression: req.AttributePathExpression,
-			Config:                  req.Config,
+			AttributeConfig:               o,
+			attributeConfigTerraformValue: req.attributeConfigTerraformValue,
+			AttributePath:                 req.AttributePath,
+			AttributePathExpression:       req.AttributePathExpression,
+			Config:                        req.Config,
+			SkipUnknownValues:             req.SkipUnknownValues,
+			TypeName:                      req.TypeName,
 		}
 		nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
@@ -923,12 +1121,25 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 
 		validateReq := validator.ObjectRequest{
 			Config:         req.Config,
+			TypeName:       req.TypeName,
 			ConfigValue:    object,
 			Path:           req.AttributePath,
 			PathExpression: req.AttributePathExpression,
 		}
 
 		for _, objectValidator := range objectWithValidators.ObjectValidators() {
+			if validatorSkipApplyOnly(ctx, objectValidator, validateReq.ConfigValue) {
+				logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+				continue
+			}
+
+			if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+				logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+				continue
+			}
+
 			// Instantiate a new response for each request to prevent validators
 			// from modifying or removing diagnostics.
 			validateResp := &validator.ObjectResponse{}
@@ -952,14 +1163,27 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 			)
 
 			resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+			if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+				logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+				break
+			}
 		}
 	}
 
+	attributeConfigs := objectAttributeValues(ctx, req.AttributeConfig)
+	attributeConfigTerraformValues := mapTerraformValues(req.attributeConfigTerraformValue)
+
 	for nestedName, nestedAttr := range o.GetAttributes() {
 		nestedAttrReq := ValidateAttributeRequest{
-			AttributePath:           req.AttributePath.AtName(nestedName),
-			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
-			Config:                  req.Config,
+			AttributeConfig:               attributeConfigs[nestedName],
+			attributeConfigTerraformValue: attributeConfigTerraformValues[nestedName],
+			AttributePath:                 req.AttributePath.AtName(nestedName),
+			AttributePathExpression:       req.AttributePathExpression.AtName(nestedName),
+			Config:                        req.Config,
+			SkipUnknownValues:             req.SkipUnknownValues,
+			TypeName:                      req.TypeName,
 		}
 		nestedAttrResp := &ValidateAttributeResponse{}
 
@@ -626,6 +626,75 @@ func TestAttributeValidate(t *testing.T) {
 			},
 			resp: ValidateAttributeResponse{},
 		},
+		"nested-attr-list-required-missing": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, nil),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.NestedAttribute{
+								NestedObject: testschema.NestedAttributeObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.Attribute{
+											Type:     types.StringType,
+											Required: true,
+										},
+									},
+								},
+								NestingMode: fwschema.NestingModeList,
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(0).AtName("nested_attr"),
+						"Missing Configuration for Required Attribute",
+						"Must set a configuration value for the test[0].nested_attr attribute as the provider has marked it as required.\n\n"+
+							"Refer to the provider documentation or contact the provider developers for additional information about configurable attributes that are required.",
+					),
+				},
+			},
+		},
 		"nested-custom-attr-list-no-validation": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -765,6 +834,110 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"nested-attr-list-validation-paths": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "first"),
+										},
+									),
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "second"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.NestedAttribute{
+								NestedObject: testschema.NestedAttributeObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.AttributeWithStringValidators{
+											Required: true,
+											Validators: []validator.String{
+												testvalidator.String{
+													ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+														resp.Diagnostics.AddAttributeError(req.Path, "Error Summary 1", req.ConfigValue.ValueString())
+													},
+												},
+												testvalidator.String{
+													ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+														resp.Diagnostics.AddAttributeError(req.Path, "Error Summary 2", req.ConfigValue.ValueString())
+													},
+												},
+											},
+										},
+									},
+								},
+								NestingMode: fwschema.NestingModeList,
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(0).AtName("nested_attr"),
+						"Error Summary 1",
+						"first",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(0).AtName("nested_attr"),
+						"Error Summary 2",
+						"first",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(1).AtName("nested_attr"),
+						"Error Summary 1",
+						"second",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(1).AtName("nested_attr"),
+						"Error Summary 2",
+						"second",
+					),
+				},
+			},
+		},
 		"nested-custom-attr-list-validation": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -2405,6 +2578,60 @@ func TestAttributeValidateList(t *testing.T) {
 			response: &ValidateAttributeResponse{},
 			expected: &ValidateAttributeResponse{},
 		},
+		"validator-applyonly-unknown-element": {
+			attribute: testschema.AttributeWithListValidators{
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					testvalidator.ListWithApplyOnly{
+						List: testvalidator.List{
+							ValidateListMethod: func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+								resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+							},
+						},
+						ApplyOnlyMethod: func(ctx context.Context) bool {
+							return true
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"validator-applyonly-known-element": {
+			attribute: testschema.AttributeWithListValidators{
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					testvalidator.ListWithApplyOnly{
+						List: testvalidator.List{
+							ValidateListMethod: func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+								resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+							},
+						},
+						ApplyOnlyMethod: func(ctx context.Context) bool {
+							return true
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
 		"response-diagnostics": {
 			attribute: testschema.AttributeWithListValidators{
 				ElementType: types.StringType,
@@ -3571,6 +3798,285 @@ func TestAttributeValidateString(t *testing.T) {
 				},
 			},
 		},
+		"validator-applyonly-known": {
+			attribute: testschema.AttributeWithStringValidators{
+				Validators: []validator.String{
+					testvalidator.StringWithApplyOnly{
+						String: testvalidator.String{
+							ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+								resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+							},
+						},
+						ApplyOnlyMethod: func(ctx context.Context) bool {
+							return true
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("test"),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"validator-applyonly-unknown": {
+			attribute: testschema.AttributeWithStringValidators{
+				Validators: []validator.String{
+					testvalidator.StringWithApplyOnly{
+						String: testvalidator.String{
+							ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+								resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+							},
+						},
+						ApplyOnlyMethod: func(ctx context.Context) bool {
+							return true
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringUnknown(),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"validator-applyonly-false-unknown": {
+			attribute: testschema.AttributeWithStringValidators{
+				Validators: []validator.String{
+					testvalidator.StringWithApplyOnly{
+						String: testvalidator.String{
+							ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+								resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+							},
+						},
+						ApplyOnlyMethod: func(ctx context.Context) bool {
+							return false
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringUnknown(),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"request-skipunknownvalues-false-unknown": {
+			attribute: testschema.AttributeWithStringValidators{
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringUnknown(),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"request-skipunknownvalues-true-known": {
+			attribute: testschema.AttributeWithStringValidators{
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:     path.Root("test"),
+				AttributeConfig:   types.StringValue("test"),
+				SkipUnknownValues: true,
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"request-skipunknownvalues-true-null": {
+			attribute: testschema.AttributeWithStringValidators{
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:     path.Root("test"),
+				AttributeConfig:   types.StringNull(),
+				SkipUnknownValues: true,
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"request-skipunknownvalues-true-unknown": {
+			attribute: testschema.AttributeWithStringValidators{
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:     path.Root("test"),
+				AttributeConfig:   types.StringUnknown(),
+				SkipUnknownValues: true,
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"response-skipremainingvalidators": {
+			attribute: testschema.AttributeWithStringValidators{
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "First Warning Summary", "First Warning Details")
+							resp.SkipRemainingValidators = true
+						},
+					},
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "Second Error Summary", "Second Error Details")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("test"),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"First Warning Summary",
+						"First Warning Details",
+					),
+				},
+			},
+		},
+		"response-skipremainingvalidators-error": {
+			attribute: testschema.AttributeWithStringValidators{
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "First Error Summary", "First Error Details")
+							resp.SkipRemainingValidators = true
+						},
+					},
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "Second Error Summary", "Second Error Details")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("test"),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"First Error Summary",
+						"First Error Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Second Error Summary",
+						"Second Error Details",
+					),
+				},
+			},
+		},
+		"response-skipremainingvalidators-unset": {
+			attribute: testschema.AttributeWithStringValidators{
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "First Warning Summary", "First Warning Details")
+						},
+					},
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "Second Error Summary", "Second Error Details")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("test"),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"First Warning Summary",
+						"First Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Second Error Summary",
+						"Second Error Details",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1637,6 +1637,9 @@ func TestBlockModifyPlan(t *testing.T) {
 				),
 			},
 		},
+		// Set elements are stored in a canonical order, so the planned elements
+		// are ordered by nested_required while the prior state elements are
+		// ordered by nested_computed, which misaligns them.
 		"block-set-nested-usestateforunknown-elements-rearranged": {
 			block: testschema.Block{
 				NestedObject: testschema.NestedBlockObject{
@@ -1672,7 +1675,7 @@ func TestBlockModifyPlan(t *testing.T) {
 							},
 							map[string]attr.Value{
 								"nested_computed": types.StringNull(),
-								"nested_required": types.StringValue("testvalue2"), // prior state on index 0 is testvalue1
+								"nested_required": types.StringValue("testvalue2"),
 							},
 						),
 						types.ObjectValueMust(
@@ -1733,7 +1736,7 @@ func TestBlockModifyPlan(t *testing.T) {
 							},
 							map[string]attr.Value{
 								"nested_computed": types.StringValue("statevalue1"),
-								"nested_required": types.StringValue("testvalue1"),
+								"nested_required": types.StringValue("testvalue2"),
 							},
 						),
 						types.ObjectValueMust(
@@ -1743,7 +1746,7 @@ func TestBlockModifyPlan(t *testing.T) {
 							},
 							map[string]attr.Value{
 								"nested_computed": types.StringValue("statevalue2"),
-								"nested_required": types.StringValue("testvalue2"),
+								"nested_required": types.StringValue("testvalue1"),
 							},
 						),
 					},
@@ -1767,7 +1770,7 @@ func TestBlockModifyPlan(t *testing.T) {
 								// TODO: Rework list/set element alignment during plan
 								// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/709
 								"nested_computed": types.StringValue("statevalue1"),
-								"nested_required": types.StringValue("testvalue2"),
+								"nested_required": types.StringValue("testvalue1"),
 							},
 						),
 						types.ObjectValueMust(
@@ -1779,7 +1782,7 @@ func TestBlockModifyPlan(t *testing.T) {
 								// TODO: Rework list/set element alignment during plan
 								// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/709
 								"nested_computed": types.StringValue("statevalue2"),
-								"nested_required": types.StringValue("testvalue1"),
+								"nested_required": types.StringValue("testvalue2"),
 							},
 						),
 					},
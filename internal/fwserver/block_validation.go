@@ -9,7 +9,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
-	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -22,13 +21,7 @@ import (
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/365
 func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
-	configData := &fwschemadata.Data{
-		Description:    fwschemadata.DataDescriptionConfiguration,
-		Schema:         req.Config.Schema,
-		TerraformValue: req.Config.Raw,
-	}
-
-	attributeConfig, diags := configData.ValueAtPath(ctx, req.AttributePath)
+	attributeConfig, attributeConfigTerraformValue, diags := attributeConfigValue(ctx, b.Type(), req)
 	resp.Diagnostics.Append(diags...)
 
 	if diags.HasError() {
@@ -36,6 +29,7 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 	}
 
 	req.AttributeConfig = attributeConfig
+	req.attributeConfigTerraformValue = attributeConfigTerraformValue
 
 	switch blockWithValidators := b.(type) {
 	case fwxschema.BlockWithListValidators:
@@ -71,12 +65,17 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 			return
 		}
 
+		elementTerraformValues := listTerraformValues(req.attributeConfigTerraformValue)
+
 		for idx, value := range l.Elements() {
 			nestedBlockObjectReq := ValidateAttributeRequest{
-				AttributeConfig:         value,
-				AttributePath:           req.AttributePath.AtListIndex(idx),
-				AttributePathExpression: req.AttributePathExpression.AtListIndex(idx),
-				Config:                  req.Config,
+				AttributeConfig:               value,
+				attributeConfigTerraformValue: listTerraformValueAt(elementTerraformValues, idx),
+				AttributePath:                 req.AttributePath.AtListIndex(idx),
+				AttributePathExpression:       req.AttributePathExpression.AtListIndex(idx),
+				Config:                        req.Config,
+				SkipUnknownValues:             req.SkipUnknownValues,
+				TypeName:                      req.TypeName,
 			}
 			nestedBlockObjectResp := &ValidateAttributeResponse{}
 
@@ -111,6 +110,8 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 				AttributePath:           req.AttributePath.AtSetValue(value),
 				AttributePathExpression: req.AttributePathExpression.AtSetValue(value),
 				Config:                  req.Config,
+				SkipUnknownValues:       req.SkipUnknownValues,
+				TypeName:                req.TypeName,
 			}
 			nestedBlockObjectResp := &ValidateAttributeResponse{}
 
@@ -140,10 +141,13 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 		}
 
 		nestedBlockObjectReq := ValidateAttributeRequest{
-			AttributeConfig:         o,
-			AttributePath:           req.AttributePath,
-			AttributePathExpression: req.AttributePathExpression,
-			Config:                  req.Config,
+			AttributeConfig:               o,
+			attributeConfigTerraformValue: req.attributeConfigTerraformValue,
+			AttributePath:                 req.AttributePath,
+			AttributePathExpression:       req.AttributePathExpression,
+			Config:                        req.Config,
+			SkipUnknownValues:             req.SkipUnknownValues,
+			TypeName:                      req.TypeName,
 		}
 		nestedBlockObjectResp := &ValidateAttributeResponse{}
 
@@ -204,12 +208,25 @@ func BlockValidateList(ctx context.Context, block fwxschema.BlockWithListValidat
 
 	validateReq := validator.ListRequest{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, blockValidator := range block.ListValidators() {
+		if validatorSkipApplyOnly(ctx, blockValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.ListResponse{}
@@ -233,6 +250,12 @@ func BlockValidateList(ctx context.Context, block fwxschema.BlockWithListValidat
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -269,12 +292,25 @@ func BlockValidateObject(ctx context.Context, block fwxschema.BlockWithObjectVal
 
 	validateReq := validator.ObjectRequest{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, blockValidator := range block.ObjectValidators() {
+		if validatorSkipApplyOnly(ctx, blockValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.ObjectResponse{}
@@ -298,6 +334,12 @@ func BlockValidateObject(ctx context.Context, block fwxschema.BlockWithObjectVal
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -334,12 +376,25 @@ func BlockValidateSet(ctx context.Context, block fwxschema.BlockWithSetValidator
 
 	validateReq := validator.SetRequest{
 		Config:         req.Config,
+		TypeName:       req.TypeName,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
 	}
 
 	for _, blockValidator := range block.SetValidators() {
+		if validatorSkipApplyOnly(ctx, blockValidator, validateReq.ConfigValue) {
+			logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+			continue
+		}
+
+		if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+			logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.SetResponse{}
@@ -363,6 +418,12 @@ func BlockValidateSet(ctx context.Context, block fwxschema.BlockWithSetValidator
 		)
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+			logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+			break
+		}
 	}
 }
 
@@ -396,12 +457,25 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 
 		validateReq := validator.ObjectRequest{
 			Config:         req.Config,
+			TypeName:       req.TypeName,
 			ConfigValue:    object,
 			Path:           req.AttributePath,
 			PathExpression: req.AttributePathExpression,
 		}
 
 		for _, objectValidator := range objectWithValidators.ObjectValidators() {
+			if validatorSkipApplyOnly(ctx, objectValidator, validateReq.ConfigValue) {
+				logging.FrameworkTrace(ctx, "Skipping provider defined apply only validator with unknown value")
+
+				continue
+			}
+
+			if req.SkipUnknownValues && validateReq.ConfigValue.IsUnknown() {
+				logging.FrameworkTrace(ctx, "Skipping provider defined validator with unknown value")
+
+				continue
+			}
+
 			// Instantiate a new response for each request to prevent validators
 			// from modifying or removing diagnostics.
 			validateResp := &validator.ObjectResponse{}
@@ -425,14 +499,27 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 			)
 
 			resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+			if validateResp.SkipRemainingValidators && !validateResp.Diagnostics.HasError() {
+				logging.FrameworkTrace(ctx, "Skipping remaining provider defined validators")
+
+				break
+			}
 		}
 	}
 
+	attributeConfigs := objectAttributeValues(ctx, req.AttributeConfig)
+	attributeConfigTerraformValues := mapTerraformValues(req.attributeConfigTerraformValue)
+
 	for nestedName, nestedAttr := range o.GetAttributes() {
 		nestedAttrReq := ValidateAttributeRequest{
-			AttributePath:           req.AttributePath.AtName(nestedName),
-			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
-			Config:                  req.Config,
+			AttributeConfig:               attributeConfigs[nestedName],
+			attributeConfigTerraformValue: attributeConfigTerraformValues[nestedName],
+			AttributePath:                 req.AttributePath.AtName(nestedName),
+			AttributePathExpression:       req.AttributePathExpression.AtName(nestedName),
+			Config:                        req.Config,
+			SkipUnknownValues:             req.SkipUnknownValues,
+			TypeName:                      req.TypeName,
 		}
 		nestedAttrResp := &ValidateAttributeResponse{}
 
@@ -443,9 +530,13 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 
 	for nestedName, nestedBlock := range o.GetBlocks() {
 		nestedBlockReq := ValidateAttributeRequest{
-			AttributePath:           req.AttributePath.AtName(nestedName),
-			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
-			Config:                  req.Config,
+			AttributeConfig:               attributeConfigs[nestedName],
+			attributeConfigTerraformValue: attributeConfigTerraformValues[nestedName],
+			AttributePath:                 req.AttributePath.AtName(nestedName),
+			AttributePathExpression:       req.AttributePathExpression.AtName(nestedName),
+			Config:                        req.Config,
+			SkipUnknownValues:             req.SkipUnknownValues,
+			TypeName:                      req.TypeName,
 		}
 		nestedBlockResp := &ValidateAttributeResponse{}
 
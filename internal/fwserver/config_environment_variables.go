@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// configEnvironmentVariableOverrides returns the configuration with the
+// values of top level attributes implementing
+// fwschema.AttributeWithEnvironmentVariable replaced by the value of their
+// environment variable, if set to a non-empty value.
+func configEnvironmentVariableOverrides(ctx context.Context, config tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config.Schema == nil || config.Raw.IsNull() {
+		return config, diags
+	}
+
+	attributes := config.Schema.GetAttributes()
+	names := make([]string, 0, len(attributes))
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	// Ensure deterministic diagnostics ordering.
+	sort.Strings(names)
+
+	data := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionConfiguration,
+		Schema:         config.Schema,
+		TerraformValue: config.Raw,
+	}
+
+	for _, name := range names {
+		attribute, ok := attributes[name].(fwschema.AttributeWithEnvironmentVariable)
+
+		if !ok || attribute.GetEnvironmentVariable() == "" {
+			continue
+		}
+
+		value := os.Getenv(attribute.GetEnvironmentVariable())
+
+		if value == "" {
+			continue
+		}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Overriding configuration value with environment variable value",
+			map[string]interface{}{
+				logging.KeyAttributePath: name,
+			},
+		)
+
+		diags.Append(data.SetAtPath(ctx, path.Root(name), value, reflect.Options{})...)
+	}
+
+	if diags.HasError() {
+		return config, diags
+	}
+
+	config.Raw = data.TerraformValue
+
+	return config, diags
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// providerMetaValidate returns error diagnostics if the provider_meta value
+// does not conform to the provider meta schema. This is performed before
+// calling provider defined logic, so mismatches are reported clearly rather
+// than when the provider attempts to read the value.
+func providerMetaValidate(ctx context.Context, providerMeta *tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if providerMeta == nil || providerMeta.Schema == nil || providerMeta.Raw.Type() == nil {
+		return diags
+	}
+
+	expectedType := providerMeta.Schema.Type().TerraformType(ctx)
+	actualType := providerMeta.Raw.Type()
+
+	if actualType.Equal(expectedType) {
+		return diags
+	}
+
+	expectedObject, expectedOk := expectedType.(tftypes.Object)
+	actualObject, actualOk := actualType.(tftypes.Object)
+
+	if expectedOk && actualOk {
+		names := make([]string, 0, len(actualObject.AttributeTypes))
+
+		for name := range actualObject.AttributeTypes {
+			names = append(names, name)
+		}
+
+		// Ensure deterministic diagnostics ordering.
+		sort.Strings(names)
+
+		for _, name := range names {
+			expectedAttributeType, ok := expectedObject.AttributeTypes[name]
+
+			if !ok {
+				diags.AddAttributeError(
+					path.Root(name),
+					"Invalid Provider Meta",
+					"The provider_meta configuration contains an attribute which is not defined in the provider meta schema. "+
+						"Remove the attribute from the provider_meta configuration.",
+				)
+
+				continue
+			}
+
+			actualAttributeType := actualObject.AttributeTypes[name]
+
+			if !actualAttributeType.Equal(expectedAttributeType) {
+				diags.AddAttributeError(
+					path.Root(name),
+					"Invalid Provider Meta",
+					"The provider_meta configuration contains an attribute value which does not match the provider meta schema. "+
+						fmt.Sprintf("Expected type %s, got type %s.", expectedAttributeType, actualAttributeType),
+				)
+			}
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.AddError(
+		"Invalid Provider Meta",
+		"The provider_meta configuration does not match the provider meta schema. "+
+			"This is always an issue with the provider or Terraform and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("Expected type: %s\nGot type: %s", expectedType, actualType),
+	)
+
+	return diags
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -91,7 +92,7 @@ func SchemaSemanticEquality(ctx context.Context, req SchemaSemanticEqualityReque
 			continue
 		}
 
-		resp.Diagnostics.Append(resp.NewData.SetAtPath(ctx, valueReq.Path, valueResp.NewValue)...)
+		resp.Diagnostics.Append(resp.NewData.SetAtPath(ctx, valueReq.Path, valueResp.NewValue, reflect.Options{})...)
 
 		if resp.Diagnostics.HasError() {
 			return
@@ -137,7 +138,7 @@ func SchemaSemanticEquality(ctx context.Context, req SchemaSemanticEqualityReque
 			continue
 		}
 
-		resp.Diagnostics.Append(resp.NewData.SetAtPath(ctx, valueReq.Path, valueResp.NewValue)...)
+		resp.Diagnostics.Append(resp.NewData.SetAtPath(ctx, valueReq.Path, valueResp.NewValue, reflect.Options{})...)
 
 		if resp.Diagnostics.HasError() {
 			return
@@ -20,6 +20,15 @@ type ValidateSchemaRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config tfsdk.Config
+
+	// SkipUnknownValues prevents attribute and block validators from being
+	// called with unknown configuration values. This is enabled by providers
+	// implementing provider.ProviderWithValidateUnknownValues.
+	SkipUnknownValues bool
+
+	// TypeName is the type name of the data source or resource. It is empty
+	// for provider configuration.
+	TypeName string
 }
 
 // ValidateSchemaResponse represents a response to a
@@ -37,12 +46,19 @@ type ValidateSchemaResponse struct {
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/365
 func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRequest, resp *ValidateSchemaResponse) {
+	attributeConfigs := configAttributeValues(ctx, req.Config)
+	attributeConfigTerraformValues := mapTerraformValues(req.Config.Raw)
+
 	for name, attribute := range s.GetAttributes() {
 
 		attributeReq := ValidateAttributeRequest{
-			AttributePath:           path.Root(name),
-			AttributePathExpression: path.MatchRoot(name),
-			Config:                  req.Config,
+			AttributeConfig:               attributeConfigs[name],
+			attributeConfigTerraformValue: attributeConfigTerraformValues[name],
+			AttributePath:                 path.Root(name),
+			AttributePathExpression:       path.MatchRoot(name),
+			Config:                        req.Config,
+			SkipUnknownValues:             req.SkipUnknownValues,
+			TypeName:                      req.TypeName,
 		}
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
@@ -55,9 +71,13 @@ func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRe
 
 	for name, block := range s.GetBlocks() {
 		attributeReq := ValidateAttributeRequest{
-			AttributePath:           path.Root(name),
-			AttributePathExpression: path.MatchRoot(name),
-			Config:                  req.Config,
+			AttributeConfig:               attributeConfigs[name],
+			attributeConfigTerraformValue: attributeConfigTerraformValues[name],
+			AttributePath:                 path.Root(name),
+			AttributePathExpression:       path.MatchRoot(name),
+			Config:                        req.Config,
+			SkipUnknownValues:             req.SkipUnknownValues,
+			TypeName:                      req.TypeName,
 		}
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
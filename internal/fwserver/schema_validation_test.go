@@ -8,10 +8,13 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -169,6 +172,142 @@ func TestSchemaValidate(t *testing.T) {
 				},
 			},
 		},
+		"nested-type-validation-list": {
+			req: ValidateSchemaRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"list_nested": tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"attr": tftypes.String,
+									},
+								},
+							},
+						},
+					}, map[string]tftypes.Value{
+						"list_nested": tftypes.NewValue(
+							tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"attr": tftypes.String,
+									},
+								},
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"attr": tftypes.String,
+									},
+								}, map[string]tftypes.Value{
+									"attr": tftypes.NewValue(tftypes.String, "one"),
+								}),
+								tftypes.NewValue(tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"attr": tftypes.String,
+									},
+								}, map[string]tftypes.Value{
+									"attr": tftypes.NewValue(tftypes.String, "two"),
+								}),
+							},
+						),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"list_nested": testschema.NestedAttribute{
+								NestedObject: testschema.NestedAttributeObject{
+									Attributes: map[string]fwschema.Attribute{
+										"attr": testschema.Attribute{
+											Optional: true,
+											Type:     testtypes.StringTypeWithValidateWarning{},
+										},
+									},
+								},
+								NestingMode: fwschema.NestingModeList,
+								Optional:    true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					testtypes.TestWarningDiagnostic(path.Root("list_nested").AtListIndex(0).AtName("attr")),
+					testtypes.TestWarningDiagnostic(path.Root("list_nested").AtListIndex(1).AtName("attr")),
+				},
+			},
+		},
+		"nested-type-validation-set": {
+			req: ValidateSchemaRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"set_nested": tftypes.Set{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"attr": tftypes.String,
+									},
+								},
+							},
+						},
+					}, map[string]tftypes.Value{
+						"set_nested": tftypes.NewValue(
+							tftypes.Set{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"attr": tftypes.String,
+									},
+								},
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"attr": tftypes.String,
+									},
+								}, map[string]tftypes.Value{
+									"attr": tftypes.NewValue(tftypes.String, "one"),
+								}),
+							},
+						),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"set_nested": testschema.NestedAttribute{
+								NestedObject: testschema.NestedAttributeObject{
+									Attributes: map[string]fwschema.Attribute{
+										"attr": testschema.Attribute{
+											Optional: true,
+											Type:     testtypes.StringTypeWithValidateWarning{},
+										},
+									},
+								},
+								NestingMode: fwschema.NestingModeSet,
+								Optional:    true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					testtypes.TestWarningDiagnostic(
+						path.Root("set_nested").AtSetValue(
+							types.ObjectValueMust(
+								map[string]attr.Type{
+									"attr": testtypes.StringTypeWithValidateWarning{},
+								},
+								map[string]attr.Value{
+									"attr": testtypes.String{
+										InternalString: types.StringValue("one"),
+										CreatedBy:      testtypes.StringTypeWithValidateWarning{},
+									},
+								},
+							),
+						).AtName("attr"),
+					),
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -185,3 +324,94 @@ func TestSchemaValidate(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkSchemaValidateNestedDepth3(b *testing.B) {
+	benchmarkSchemaValidateNested(b, 3, 3)
+}
+
+func BenchmarkSchemaValidateNestedDepth5(b *testing.B) {
+	benchmarkSchemaValidateNested(b, 5, 3)
+}
+
+func BenchmarkSchemaValidateNestedDepth7(b *testing.B) {
+	benchmarkSchemaValidateNested(b, 7, 2)
+}
+
+// benchmarkSchemaValidateNested runs SchemaValidate over a configuration of
+// list nested attributes, depth levels deep, where each list contains width
+// elements.
+func benchmarkSchemaValidateNested(b *testing.B, depth int, width int) {
+	ctx := context.Background()
+
+	nestedAttribute, nestedValue := benchmarkNestedAttribute(ctx, depth, width)
+
+	config := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested": nestedValue.Type(),
+				},
+			},
+			map[string]tftypes.Value{
+				"nested": nestedValue,
+			},
+		),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"nested": nestedAttribute,
+			},
+		},
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		resp := &ValidateSchemaResponse{}
+
+		SchemaValidate(ctx, config.Schema, ValidateSchemaRequest{Config: config}, resp)
+
+		if resp.Diagnostics.HasError() {
+			b.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+		}
+	}
+}
+
+// benchmarkNestedAttribute returns a list nested attribute containing a
+// validated string attribute and, unless depth is 1, another list nested
+// attribute one level shallower, along with a matching configuration value.
+func benchmarkNestedAttribute(ctx context.Context, depth int, width int) (fwschema.Attribute, tftypes.Value) {
+	attributes := map[string]fwschema.Attribute{
+		"value": testschema.AttributeWithStringValidators{
+			Optional: true,
+			Validators: []validator.String{
+				testvalidator.String{
+					ValidateStringMethod: func(context.Context, validator.StringRequest, *validator.StringResponse) {},
+				},
+			},
+		},
+	}
+	values := map[string]tftypes.Value{
+		"value": tftypes.NewValue(tftypes.String, "test"),
+	}
+
+	if depth > 1 {
+		attributes["nested"], values["nested"] = benchmarkNestedAttribute(ctx, depth-1, width)
+	}
+
+	attribute := testschema.NestedAttribute{
+		NestedObject: testschema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+		NestingMode: fwschema.NestingModeList,
+		Optional:    true,
+	}
+
+	elementType := attribute.GetNestedObject().Type().TerraformType(ctx)
+	elements := make([]tftypes.Value, width)
+
+	for index := range elements {
+		elements[index] = tftypes.NewValue(elementType, values)
+	}
+
+	return attribute, tftypes.NewValue(tftypes.List{ElementType: elementType}, elements)
+}
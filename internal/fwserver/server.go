@@ -111,8 +111,29 @@ type Server struct {
 	resourceTypesMutex sync.Mutex
 }
 
-// DataSource returns the DataSource for a given type name.
+// DataSource returns the DataSource for a given type name. If the provider
+// implements ProviderWithDataSourceByTypeName, only the requested data source
+// is instantiated.
 func (s *Server) DataSource(ctx context.Context, typeName string) (datasource.DataSource, diag.Diagnostics) {
+	if providerWithDataSourceByTypeName, ok := s.Provider.(provider.ProviderWithDataSourceByTypeName); ok {
+		var diags diag.Diagnostics
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Provider DataSourceByTypeName", map[string]interface{}{logging.KeyDataSourceType: typeName})
+		dataSourceFunc := providerWithDataSourceByTypeName.DataSourceByTypeName(ctx, typeName)
+		logging.FrameworkDebug(ctx, "Called provider defined Provider DataSourceByTypeName", map[string]interface{}{logging.KeyDataSourceType: typeName})
+
+		if dataSourceFunc == nil {
+			diags.AddError(
+				"Data Source Type Not Found",
+				fmt.Sprintf("No data source type named %q was found in the provider.", typeName),
+			)
+
+			return nil, diags
+		}
+
+		return dataSourceFunc(), diags
+	}
+
 	dataSourceFuncs, diags := s.DataSourceFuncs(ctx)
 
 	dataSourceFunc, ok := dataSourceFuncs[typeName]
@@ -329,8 +350,29 @@ func (s *Server) ProviderMetaSchema(ctx context.Context) (fwschema.Schema, diag.
 	return s.providerMetaSchema, s.providerMetaSchemaDiags
 }
 
-// Resource returns the Resource for a given type name.
+// Resource returns the Resource for a given type name. If the provider
+// implements ProviderWithResourceByTypeName, only the requested resource is
+// instantiated.
 func (s *Server) Resource(ctx context.Context, typeName string) (resource.Resource, diag.Diagnostics) {
+	if providerWithResourceByTypeName, ok := s.Provider.(provider.ProviderWithResourceByTypeName); ok {
+		var diags diag.Diagnostics
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Provider ResourceByTypeName", map[string]interface{}{logging.KeyResourceType: typeName})
+		resourceFunc := providerWithResourceByTypeName.ResourceByTypeName(ctx, typeName)
+		logging.FrameworkDebug(ctx, "Called provider defined Provider ResourceByTypeName", map[string]interface{}{logging.KeyResourceType: typeName})
+
+		if resourceFunc == nil {
+			diags.AddError(
+				"Resource Type Not Found",
+				fmt.Sprintf("No resource type named %q was found in the provider.", typeName),
+			)
+
+			return nil, diags
+		}
+
+		return resourceFunc(), diags
+	}
+
 	resourceFuncs, diags := s.ResourceFuncs(ctx)
 
 	resourceFunc, ok := resourceFuncs[typeName]
@@ -5,6 +5,7 @@ package fwserver
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -12,16 +13,91 @@ import (
 
 // ConfigureProvider implements the framework server ConfigureProvider RPC.
 func (s *Server) ConfigureProvider(ctx context.Context, req *provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	logging.FrameworkDebug(ctx, "Calling provider defined Provider Configure")
+	if req == nil {
+		req = &provider.ConfigureRequest{}
+	}
+
+	if providerWithMinimumTerraformVersion, ok := s.Provider.(provider.ProviderWithMinimumTerraformVersion); ok {
+		logging.FrameworkTrace(ctx, "Provider implements ProviderWithMinimumTerraformVersion")
+
+		s.checkMinimumTerraformVersion(ctx, providerWithMinimumTerraformVersion, req.TerraformVersion, resp)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	config, diags := configEnvironmentVariableOverrides(ctx, req.Config)
 
-	if req != nil {
-		s.Provider.Configure(ctx, *req, resp)
-	} else {
-		s.Provider.Configure(ctx, provider.ConfigureRequest{}, resp)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	configureReq := *req
+	configureReq.Config = config
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider Configure")
+	s.Provider.Configure(ctx, configureReq, resp)
 	logging.FrameworkDebug(ctx, "Called provider defined Provider Configure")
 
 	s.DataSourceConfigureData = resp.DataSourceData
 	s.ResourceConfigureData = resp.ResourceData
 }
+
+// checkMinimumTerraformVersion adds an error diagnostic to the response if
+// the Terraform version executing the request is earlier than the minimum
+// Terraform version declared by the provider.
+func (s *Server) checkMinimumTerraformVersion(ctx context.Context, p provider.ProviderWithMinimumTerraformVersion, rawTerraformVersion string, resp *provider.ConfigureResponse) {
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider MinimumTerraformVersion")
+	rawMinimumVersion := p.MinimumTerraformVersion(ctx)
+	logging.FrameworkDebug(ctx, "Called provider defined Provider MinimumTerraformVersion")
+
+	if rawMinimumVersion == "" {
+		return
+	}
+
+	minimumVersion, err := parseTerraformVersion(rawMinimumVersion)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Minimum Terraform Version",
+			"The provider declared an invalid minimum Terraform version. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+
+		return
+	}
+
+	// Terraform may not advertise its version, such as when the provider is
+	// executed by other tooling, so the check is skipped.
+	if rawTerraformVersion == "" {
+		logging.FrameworkDebug(ctx, "Skipping minimum Terraform version check as no Terraform version was provided")
+
+		return
+	}
+
+	terraformVersion, err := parseTerraformVersion(rawTerraformVersion)
+
+	if err != nil {
+		logging.FrameworkWarn(
+			ctx,
+			"Skipping minimum Terraform version check as the Terraform version could not be parsed",
+			map[string]interface{}{
+				logging.KeyError: err.Error(),
+			},
+		)
+
+		return
+	}
+
+	if terraformVersion.LessThan(minimumVersion) {
+		resp.Diagnostics.AddError(
+			"Unsupported Terraform Version",
+			fmt.Sprintf("This provider requires Terraform version %s or later, but it is being executed by Terraform version %s. ", rawMinimumVersion, rawTerraformVersion)+
+				"Upgrade Terraform to a supported version or use an earlier version of the provider.",
+		)
+	}
+}
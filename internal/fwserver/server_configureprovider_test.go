@@ -56,6 +56,159 @@ func TestServerConfigureProvider(t *testing.T) {
 			},
 			expectedResponse: &provider.ConfigureResponse{},
 		},
+		"minimumterraformversion-empty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithMinimumTerraformVersion{
+					Provider: &testprovider.Provider{
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.ResourceData = "test-provider-configure-value"
+						},
+					},
+					MinimumTerraformVersionMethod: func(_ context.Context) string {
+						return ""
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				TerraformVersion: "1.0.0",
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				ResourceData: "test-provider-configure-value",
+			},
+		},
+		"minimumterraformversion-equal": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithMinimumTerraformVersion{
+					Provider: &testprovider.Provider{
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.ResourceData = "test-provider-configure-value"
+						},
+					},
+					MinimumTerraformVersionMethod: func(_ context.Context) string {
+						return "1.3.0"
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				TerraformVersion: "1.3.0",
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				ResourceData: "test-provider-configure-value",
+			},
+		},
+		"minimumterraformversion-greater": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithMinimumTerraformVersion{
+					Provider: &testprovider.Provider{
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.ResourceData = "test-provider-configure-value"
+						},
+					},
+					MinimumTerraformVersionMethod: func(_ context.Context) string {
+						return "1.3.0"
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				TerraformVersion: "1.10.2",
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				ResourceData: "test-provider-configure-value",
+			},
+		},
+		"minimumterraformversion-prerelease": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithMinimumTerraformVersion{
+					Provider: &testprovider.Provider{
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.ResourceData = "test-provider-configure-value"
+						},
+					},
+					MinimumTerraformVersionMethod: func(_ context.Context) string {
+						return "1.3.0"
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				TerraformVersion: "1.3.0-dev",
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				ResourceData: "test-provider-configure-value",
+			},
+		},
+		"minimumterraformversion-request-terraformversion-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithMinimumTerraformVersion{
+					Provider: &testprovider.Provider{
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.ResourceData = "test-provider-configure-value"
+						},
+					},
+					MinimumTerraformVersionMethod: func(_ context.Context) string {
+						return "1.3.0"
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				TerraformVersion: "",
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				ResourceData: "test-provider-configure-value",
+			},
+		},
+		"minimumterraformversion-less": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithMinimumTerraformVersion{
+					Provider: &testprovider.Provider{
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.ResourceData = "test-provider-configure-value"
+						},
+					},
+					MinimumTerraformVersionMethod: func(_ context.Context) string {
+						return "1.3.0"
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				TerraformVersion: "1.2.9",
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unsupported Terraform Version",
+						"This provider requires Terraform version 1.3.0 or later, but it is being executed by Terraform version 1.2.9. "+
+							"Upgrade Terraform to a supported version or use an earlier version of the provider.",
+					),
+				},
+			},
+		},
+		"minimumterraformversion-invalid": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithMinimumTerraformVersion{
+					Provider: &testprovider.Provider{
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.ResourceData = "test-provider-configure-value"
+						},
+					},
+					MinimumTerraformVersionMethod: func(_ context.Context) string {
+						return "1.x"
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				TerraformVersion: "1.3.0",
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Provider Minimum Terraform Version",
+						"The provider declared an invalid minimum Terraform version. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Error: version \"1.x\" contains invalid segment \"x\"",
+					),
+				},
+			},
+		},
 		"request-config": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -175,3 +328,89 @@ func TestServerConfigureProvider(t *testing.T) {
 		})
 	}
 }
+
+//nolint:paralleltest // Test sets environment variables
+func TestServerConfigureProvider_environmentVariable(t *testing.T) {
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				EnvironmentVariable: "TF_FRAMEWORK_TEST_CONFIGURE_PROVIDER",
+				Optional:            true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		environmentValue string
+		configValue      tftypes.Value
+		expected         types.String
+	}{
+		"config-only": {
+			configValue: tftypes.NewValue(tftypes.String, "config-value"),
+			expected:    types.StringValue("config-value"),
+		},
+		"config-null": {
+			configValue: tftypes.NewValue(tftypes.String, nil),
+			expected:    types.StringNull(),
+		},
+		"env-overrides-config": {
+			environmentValue: "env-value",
+			configValue:      tftypes.NewValue(tftypes.String, "config-value"),
+			expected:         types.StringValue("env-value"),
+		},
+		"env-overrides-config-null": {
+			environmentValue: "env-value",
+			configValue:      tftypes.NewValue(tftypes.String, nil),
+			expected:         types.StringValue("env-value"),
+		},
+		"env-empty": {
+			environmentValue: "",
+			configValue:      tftypes.NewValue(tftypes.String, "config-value"),
+			expected:         types.StringValue("config-value"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("TF_FRAMEWORK_TEST_CONFIGURE_PROVIDER", testCase.environmentValue)
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						var got types.String
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+						if !got.Equal(testCase.expected) {
+							resp.Diagnostics.AddError("Unexpected Config Value", "expected "+testCase.expected.String()+", got "+got.String())
+						}
+					},
+				},
+			}
+
+			request := &provider.ConfigureRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test": testCase.configValue,
+					}),
+					Schema: testSchema,
+				},
+			}
+			response := &provider.ConfigureResponse{}
+
+			server.ConfigureProvider(context.Background(), request, response)
+
+			if diff := cmp.Diff(response, &provider.ConfigureResponse{}); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -43,6 +43,12 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(providerMetaValidate(ctx, req.ProviderMeta)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -132,6 +138,22 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		return
 	}
 
+	// Preserve the plan representation of empty or null values for
+	// attributes which treat them as equivalent.
+	treatEmptyAsNullData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         resp.NewState.Schema,
+		TerraformValue: resp.NewState.Raw,
+	}
+
+	resp.Diagnostics.Append(treatEmptyAsNullData.TransformTreatEmptyAsNull(ctx, req.PlannedState.Raw)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.NewState.Raw = treatEmptyAsNullData.TerraformValue
+
 	semanticEqualityReq := SchemaSemanticEqualityRequest{
 		PriorData: fwschemadata.Data{
 			Description:    fwschemadata.DataDescriptionPlan,
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -130,6 +131,30 @@ func TestServerCreateResource(t *testing.T) {
 		Provider: testEmptyProviderData,
 	}
 
+	testSchemaTypeTreatEmptyAsNull := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testSchemaTreatEmptyAsNull := func(treatEmptyAsNull bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"test_list": schema.ListAttribute{
+					ElementType:      types.StringType,
+					Optional:         true,
+					TreatEmptyAsNull: treatEmptyAsNull,
+				},
+			},
+		}
+	}
+
+	testTreatEmptyAsNullValue := func(elements interface{}) tftypes.Value {
+		return tftypes.NewValue(testSchemaTypeTreatEmptyAsNull, map[string]tftypes.Value{
+			"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements),
+		})
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.CreateResourceRequest
@@ -433,6 +458,90 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-newstate-partial-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						// Simulate a long-running creation which saves a
+						// computed value as soon as it is known, then fails.
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), "test-partial-value")...)
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-partial-value"),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newstate-partial-plan-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						var data testSchemaData
+
+						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+						// Save the planned values with the computed value
+						// once known, before a later step fails.
+						data.TestComputed = types.StringValue("test-partial-value")
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-partial-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-newstate-null": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -506,6 +615,122 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-newstate-treat-empty-as-null-config-empty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				ResourceSchema: testSchemaTreatEmptyAsNull(true),
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), types.ListNull(types.StringType))...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					// The plan representation should be kept.
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newstate-treat-empty-as-null-config-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				ResourceSchema: testSchemaTreatEmptyAsNull(true),
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), []string{})...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					// The plan representation should be kept.
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newstate-treat-empty-as-null-disabled-config-empty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				ResourceSchema: testSchemaTreatEmptyAsNull(false),
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), types.ListNull(types.StringType))...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					// The response representation should be kept.
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newstate-treat-empty-as-null-disabled-config-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				ResourceSchema: testSchemaTreatEmptyAsNull(false),
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), []string{})...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					// The response representation should be kept.
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -41,6 +41,12 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(providerMetaValidate(ctx, req.ProviderMeta)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
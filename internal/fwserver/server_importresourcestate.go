@@ -128,9 +128,38 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		private.Provider = importResp.Private
 	}
 
+	importedState := importResp.State
+
+	if importResp.ReadAfterImport {
+		logging.FrameworkTrace(ctx, "Resource ImportState response requested read after import")
+
+		readReq := &ReadResourceRequest{
+			CurrentState: &importResp.State,
+			Resource:     req.Resource,
+			Private:      private,
+		}
+		readResp := &ReadResourceResponse{}
+
+		s.ReadResource(ctx, readReq, readResp)
+
+		resp.Diagnostics.Append(readResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if readResp.NewState != nil {
+			importedState = *readResp.NewState
+		}
+
+		if readResp.Private != nil {
+			private = readResp.Private
+		}
+	}
+
 	resp.ImportedResources = []ImportedResource{
 		{
-			State:    importResp.State,
+			State:    importedState,
 			TypeName: req.TypeName,
 			Private:  private,
 		},
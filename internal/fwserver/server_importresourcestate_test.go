@@ -6,6 +6,7 @@ package fwserver_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -19,8 +20,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// testImportStateData is the resource model for import tests.
+type testImportStateData struct {
+	ID       types.String `tfsdk:"id"`
+	Optional types.String `tfsdk:"optional"`
+	Required types.String `tfsdk:"required"`
+}
+
 func TestServerImportResourceState(t *testing.T) {
 	t.Parallel()
 
@@ -245,6 +254,122 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"response-importedresources-multiple-attributes": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id,test-optional,test-required",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						idParts := strings.Split(req.ID, ",")
+
+						data := testImportStateData{
+							ID:       types.StringValue(idParts[0]),
+							Optional: types.StringValue(idParts[1]),
+							Required: types.StringValue(idParts[2]),
+						}
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State: tfsdk.State{
+							Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+								"id":       tftypes.NewValue(tftypes.String, "test-id"),
+								"optional": tftypes.NewValue(tftypes.String, "test-optional"),
+								"required": tftypes.NewValue(tftypes.String, "test-required"),
+							}),
+							Schema: testSchema,
+						},
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
+		"response-readafterimport": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							var id types.String
+
+							resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+
+							if id.ValueString() != "test-id" {
+								resp.Diagnostics.AddError("unexpected req.State id value", id.ValueString())
+							}
+
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("optional"), "test-read-value")...)
+						},
+					},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+						resp.ReadAfterImport = true
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State: tfsdk.State{
+							Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+								"id":       tftypes.NewValue(tftypes.String, "test-id"),
+								"optional": tftypes.NewValue(tftypes.String, "test-read-value"),
+								"required": tftypes.NewValue(tftypes.String, nil),
+							}),
+							Schema: testSchema,
+						},
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
+		"response-readafterimport-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.AddError("error summary", "error detail")
+						},
+					},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+						resp.ReadAfterImport = true
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+			},
+		},
 		"response-importedresources-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -316,3 +441,94 @@ func TestServerImportResourceState(t *testing.T) {
 		})
 	}
 }
+
+func TestServerImportResourceState_read(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"optional": schema.StringAttribute{
+				Optional: true,
+			},
+			"required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	expected := testImportStateData{
+		ID:       types.StringValue("test-id"),
+		Optional: types.StringValue("test-optional"),
+		Required: types.StringValue("test-required"),
+	}
+
+	var readData testImportStateData
+
+	testResource := &testprovider.ResourceWithImportState{
+		Resource: &testprovider.Resource{
+			ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.Append(req.State.Get(ctx, &readData)...)
+			},
+		},
+		ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+			idParts := strings.Split(req.ID, ",")
+
+			data := testImportStateData{
+				ID:       types.StringValue(idParts[0]),
+				Optional: types.StringValue(idParts[1]),
+				Required: types.StringValue(idParts[2]),
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+		},
+	}
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+
+	importResp := &fwserver.ImportResourceStateResponse{}
+	server.ImportResourceState(ctx, &fwserver.ImportResourceStateRequest{
+		EmptyState: tfsdk.State{
+			Raw:    tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil),
+			Schema: testSchema,
+		},
+		ID:       "test-id,test-optional,test-required",
+		Resource: testResource,
+		TypeName: "test_resource",
+	}, importResp)
+
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected import diagnostics: %v", importResp.Diagnostics)
+	}
+
+	if len(importResp.ImportedResources) != 1 {
+		t.Fatalf("expected 1 imported resource, got: %d", len(importResp.ImportedResources))
+	}
+
+	importedResource := importResp.ImportedResources[0]
+
+	readResp := &fwserver.ReadResourceResponse{}
+	server.ReadResource(ctx, &fwserver.ReadResourceRequest{
+		CurrentState: &importedResource.State,
+		Private:      importedResource.Private,
+		Resource:     testResource,
+	}, readResp)
+
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(readData, expected); diff != "" {
+		t.Errorf("unexpected read state difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(readResp.NewState, &importedResource.State); diff != "" {
+		t.Errorf("unexpected new state difference: %s", diff)
+	}
+}
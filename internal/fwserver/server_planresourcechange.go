@@ -49,6 +49,12 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		return
 	}
 
+	resp.Diagnostics.Append(providerMetaValidate(ctx, req.ProviderMeta)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -133,6 +139,59 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		resp.PlannedState.Raw = data.TerraformValue
 	}
 
+	// Run semantic equality logic against the prior state.
+	//
+	// If a planned value is semantically equal to the prior state value, such
+	// as when an upstream system returns cosmetic differences, the prior state
+	// value is kept to prevent spurious plan differences. Terraform accepts a
+	// planned value which equals either the configuration value or the prior
+	// state value, so prior state values are only kept where both the
+	// configuration and prior state values are not null. This is before any
+	// Computed-only attributes are marked as unknown, so they remain known if
+	// the entire plan becomes equal to the prior state.
+	if !resp.PlannedState.Raw.IsNull() && !req.PriorState.Raw.IsNull() {
+		semanticEqualityReq := SchemaSemanticEqualityRequest{
+			PriorData: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         req.PriorState.Schema,
+				TerraformValue: req.PriorState.Raw.Copy(),
+			},
+			ProposedNewData: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionPlan,
+				Schema:         resp.PlannedState.Schema,
+				TerraformValue: resp.PlannedState.Raw.Copy(),
+			},
+		}
+		semanticEqualityResp := &SchemaSemanticEqualityResponse{
+			NewData: semanticEqualityReq.ProposedNewData,
+		}
+
+		SchemaSemanticEquality(ctx, semanticEqualityReq, semanticEqualityResp)
+
+		resp.Diagnostics.Append(semanticEqualityResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		semanticEqualityPlan, err := tftypes.Transform(semanticEqualityResp.NewData.TerraformValue, RestoreNullConfigPlannedValues(ctx, req.Config.Raw, resp.PlannedState.Raw))
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error modifying plan",
+				"There was an unexpected error updating the plan. This is always a problem with the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
+			)
+
+			return
+		}
+
+		if !semanticEqualityPlan.Equal(resp.PlannedState.Raw) {
+			logging.FrameworkDebug(ctx, "Planned state updated due to semantic equality")
+
+			resp.PlannedState.Raw = semanticEqualityPlan
+		}
+	}
+
 	// Execute any AttributePlanModifiers.
 	//
 	// This pass is before any Computed-only attributes are marked as unknown
@@ -232,7 +291,7 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error modifying plan",
-				"There was an unexpected error updating the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"There was an unexpected error updating the plan. This is always a problem with the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			)
 
 			return
@@ -333,6 +392,55 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 	}
 }
 
+// RestoreNullConfigPlannedValues returns a tftypes.Transform function which
+// replaces values with the value from the given planned state wherever the
+// configuration value is null. This prevents semantic equality logic from
+// keeping a prior state value where the configuration did not set a value.
+// Values without a configuration value at the same path, such as set elements
+// which were replaced with a semantically equal prior state element, are kept.
+func RestoreNullConfigPlannedValues(ctx context.Context, config tftypes.Value, plannedState tftypes.Value) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		ctx := logging.FrameworkWithAttributePath(ctx, path.String())
+
+		// we are only modifying attributes, not the entire resource
+		if len(path.Steps()) < 1 {
+			return val, nil
+		}
+
+		configValIface, _, err := tftypes.WalkAttributePath(config, path)
+
+		if err != nil {
+			return val, nil //nolint:nilerr // Values without configuration are kept.
+		}
+
+		configVal, ok := configValIface.(tftypes.Value)
+
+		if !ok || !configVal.IsNull() {
+			return val, nil
+		}
+
+		plannedValIface, _, err := tftypes.WalkAttributePath(plannedState, path)
+
+		if err != nil {
+			logging.FrameworkError(ctx, "couldn't find planned value for null configuration value")
+
+			return tftypes.Value{}, fmt.Errorf("error walking planned state path: %w", err)
+		}
+
+		plannedVal, ok := plannedValIface.(tftypes.Value)
+
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("unexpected type during planned value restoration: %T", plannedValIface)
+		}
+
+		if !plannedVal.Equal(val) {
+			logging.FrameworkTrace(ctx, "configuration value is null, restoring planned value")
+		}
+
+		return plannedVal, nil
+	}
+}
+
 func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resourceSchema fwschema.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
 	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
 		ctx = logging.FrameworkWithAttributePath(ctx, path.String())
@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -297,6 +298,106 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 	}
 }
 
+func TestRestoreNullConfigPlannedValues(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"string-config": tftypes.String,
+			"string-null":   tftypes.String,
+		},
+	}
+
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"string-config":    tftypes.String,
+			"string-null":      tftypes.String,
+			"list-nested":      tftypes.List{ElementType: nestedType},
+			"list-nested-null": tftypes.List{ElementType: nestedType},
+			"set-nested":       tftypes.Set{ElementType: nestedType},
+		},
+	}
+
+	nestedValue := func(config interface{}, null interface{}) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"string-config": tftypes.NewValue(tftypes.String, config),
+			"string-null":   tftypes.NewValue(tftypes.String, null),
+		})
+	}
+
+	config := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"string-config": tftypes.NewValue(tftypes.String, "CONFIG"),
+		"string-null":   tftypes.NewValue(tftypes.String, nil),
+		"list-nested": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			nestedValue("CONFIG", nil),
+		}),
+		"list-nested-null": tftypes.NewValue(tftypes.List{ElementType: nestedType}, nil),
+		"set-nested": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+			nestedValue("CONFIG", nil),
+		}),
+	})
+
+	planned := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"string-config": tftypes.NewValue(tftypes.String, "CONFIG"),
+		"string-null":   tftypes.NewValue(tftypes.String, "DEFAULT"),
+		"list-nested": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			nestedValue("CONFIG", "DEFAULT"),
+		}),
+		"list-nested-null": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			nestedValue("DEFAULT", "DEFAULT"),
+		}),
+		"set-nested": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+			nestedValue("CONFIG", nil),
+		}),
+	})
+
+	input := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"string-config": tftypes.NewValue(tftypes.String, "config"),
+		"string-null":   tftypes.NewValue(tftypes.String, "default"),
+		"list-nested": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			nestedValue("config", "default"),
+		}),
+		"list-nested-null": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			nestedValue("default", "default"),
+		}),
+		"set-nested": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+			nestedValue("config", nil),
+		}),
+	})
+
+	expected := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		// prior state values should be kept for configured values
+		"string-config": tftypes.NewValue(tftypes.String, "config"),
+		// planned values should be restored for null configuration values
+		"string-null": tftypes.NewValue(tftypes.String, "DEFAULT"),
+		"list-nested": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			nestedValue("config", "DEFAULT"),
+		}),
+		"list-nested-null": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			nestedValue("DEFAULT", "DEFAULT"),
+		}),
+		// set elements without a matching configuration element should be kept
+		"set-nested": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+			nestedValue("config", nil),
+		}),
+	})
+
+	got, err := tftypes.Transform(input, fwserver.RestoreNullConfigPlannedValues(context.Background(), config, planned))
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	diff, err := expected.Diff(got)
+	if err != nil {
+		t.Errorf("Error diffing values: %s", err)
+		return
+	}
+	if len(diff) > 0 {
+		t.Errorf("Unexpected diff (value1 expected, value2 got): %v", diff)
+	}
+}
+
 func TestNormaliseRequiresReplace(t *testing.T) {
 	t.Parallel()
 
@@ -417,6 +518,41 @@ func TestServerPlanResourceChange(t *testing.T) {
 		},
 	}
 
+	testSchemaTypeOptionalComputed := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_optional_computed": tftypes.String,
+			"test_required":          tftypes.String,
+		},
+	}
+
+	testSchemaOptionalComputed := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_optional_computed": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testSchemaSemanticEquals := func(semanticEquals bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"test_computed": schema.StringAttribute{
+					Computed: true,
+				},
+				"test_required": schema.StringAttribute{
+					CustomType: testtypes.StringTypeWithSemanticEquals{
+						SemanticEquals: semanticEquals,
+					},
+					Required: true,
+				},
+			},
+		}
+	}
+
 	testSchemaDefault := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_computed_bool": schema.BoolAttribute{
@@ -775,6 +911,75 @@ func TestServerPlanResourceChange(t *testing.T) {
 		},
 	}
 
+	testSchemaTypeNestedRequiresReplace := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list_nested": tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"nested": tftypes.String,
+					},
+				},
+			},
+			"test_single_block": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested": tftypes.String,
+				},
+			},
+		},
+	}
+
+	testSchemaNestedRequiresReplace := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"nested": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"test_single_block": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"nested": schema.StringAttribute{
+						Optional: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testNestedRequiresReplaceValue := func(listNested string, singleBlock string) tftypes.Value {
+		return tftypes.NewValue(testSchemaTypeNestedRequiresReplace, map[string]tftypes.Value{
+			"test_list_nested": tftypes.NewValue(
+				testSchemaTypeNestedRequiresReplace.AttributeTypes["test_list_nested"],
+				[]tftypes.Value{
+					tftypes.NewValue(
+						testSchemaTypeNestedRequiresReplace.AttributeTypes["test_single_block"],
+						map[string]tftypes.Value{
+							"nested": tftypes.NewValue(tftypes.String, listNested),
+						},
+					),
+				},
+			),
+			"test_single_block": tftypes.NewValue(
+				testSchemaTypeNestedRequiresReplace.AttributeTypes["test_single_block"],
+				map[string]tftypes.Value{
+					"nested": tftypes.NewValue(tftypes.String, singleBlock),
+				},
+			),
+		})
+	}
+
 	testProviderMetaType := tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
 			"test_provider_meta_attribute": tftypes.String,
@@ -927,6 +1132,80 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"create-optional-computed-config-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaTypeOptionalComputed, nil),
+					Schema: testSchemaOptionalComputed,
+				},
+				ResourceSchema: testSchemaOptionalComputed,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-optional-computed-config-value": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-config-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-config-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaTypeOptionalComputed, nil),
+					Schema: testSchemaOptionalComputed,
+				},
+				ResourceSchema: testSchemaOptionalComputed,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-config-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"create-set-default-values": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -2428,6 +2707,206 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"update-semantic-equality": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "TEST-VALUE"),
+					}),
+					Schema: testSchemaSemanticEquals(true),
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "TEST-VALUE"),
+					}),
+					Schema: testSchemaSemanticEquals(true),
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+					}),
+					Schema: testSchemaSemanticEquals(true),
+				},
+				ResourceSchema: testSchemaSemanticEquals(true),
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+					}),
+					Schema: testSchemaSemanticEquals(true),
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-semantic-equality-not-equal": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "TEST-VALUE"),
+					}),
+					Schema: testSchemaSemanticEquals(false),
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "TEST-VALUE"),
+					}),
+					Schema: testSchemaSemanticEquals(false),
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+					}),
+					Schema: testSchemaSemanticEquals(false),
+				},
+				ResourceSchema: testSchemaSemanticEquals(false),
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "TEST-VALUE"),
+					}),
+					Schema: testSchemaSemanticEquals(false),
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-optional-computed-config-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				ResourceSchema: testSchemaOptionalComputed,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-optional-computed-config-null-no-changes": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				ResourceSchema: testSchemaOptionalComputed,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-optional-computed-config-value": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-config-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-config-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				ResourceSchema: testSchemaOptionalComputed,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeOptionalComputed, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.String, "test-config-value"),
+						"test_required":          tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"update-set-default-values": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -3325,6 +3804,128 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"update-nested-requiresreplace-no-changes": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testNestedRequiresReplaceValue("test-old-value", "test-old-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testNestedRequiresReplaceValue("test-old-value", "test-old-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testNestedRequiresReplaceValue("test-old-value", "test-old-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				ResourceSchema: testSchemaNestedRequiresReplace,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    testNestedRequiresReplaceValue("test-old-value", "test-old-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-nested-requiresreplace-list-nested-attribute": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testNestedRequiresReplaceValue("test-new-value", "test-old-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testNestedRequiresReplaceValue("test-new-value", "test-old-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testNestedRequiresReplaceValue("test-old-value", "test-old-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				ResourceSchema: testSchemaNestedRequiresReplace,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    testNestedRequiresReplaceValue("test-new-value", "test-old-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				RequiresReplace: path.Paths{
+					path.Root("test_list_nested").AtListIndex(0).AtName("nested"),
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-nested-requiresreplace-single-nested-block": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testNestedRequiresReplaceValue("test-old-value", "test-new-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testNestedRequiresReplaceValue("test-old-value", "test-new-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testNestedRequiresReplaceValue("test-old-value", "test-old-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				ResourceSchema: testSchemaNestedRequiresReplace,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    testNestedRequiresReplaceValue("test-old-value", "test-new-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				RequiresReplace: path.Paths{
+					path.Root("test_single_block").AtName("nested"),
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-nested-requiresreplace-multiple": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testNestedRequiresReplaceValue("test-new-value", "test-new-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testNestedRequiresReplaceValue("test-new-value", "test-new-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testNestedRequiresReplaceValue("test-old-value", "test-old-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				ResourceSchema: testSchemaNestedRequiresReplace,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    testNestedRequiresReplaceValue("test-new-value", "test-new-value"),
+					Schema: testSchemaNestedRequiresReplace,
+				},
+				RequiresReplace: path.Paths{
+					path.Root("test_list_nested").AtListIndex(0).AtName("nested"),
+					path.Root("test_single_block").AtName("nested"),
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"update-resourcewithmodifyplan-request-config": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
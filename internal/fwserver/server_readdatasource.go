@@ -36,6 +36,12 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(providerMetaValidate(ctx, req.ProviderMeta)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if dataSourceWithConfigure, ok := req.DataSource.(datasource.DataSourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
@@ -82,6 +88,14 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 	resp.Diagnostics = readResp.Diagnostics
 	resp.State = &readResp.State
 
+	if dataSourceWithDeprecation, ok := req.DataSource.(datasource.DataSourceWithDeprecation); ok {
+		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithDeprecation")
+
+		if message := dataSourceWithDeprecation.DeprecationMessage(ctx); message != "" {
+			resp.Diagnostics.AddWarning("Data Source Deprecated", message)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
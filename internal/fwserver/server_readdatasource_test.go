@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -162,6 +163,45 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testStateUnchanged,
 			},
 		},
+		"request-providermeta-invalid-type": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						resp.Diagnostics.AddError("unexpected Read call", "")
+					},
+				},
+				ProviderMeta: &tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test_computed": tftypes.String,
+								"test_required": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test_computed": tftypes.NewValue(tftypes.String, nil),
+							"test_required": tftypes.NewValue(tftypes.Number, 1),
+						},
+					),
+					Schema: testSchema,
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Invalid Provider Meta",
+						"The provider_meta configuration contains an attribute value which does not match the provider meta schema. "+
+							"Expected type tftypes.String, got type tftypes.Number.",
+					),
+				},
+			},
+		},
 		"resource-configure-data": {
 			server: &fwserver.Server{
 				DataSourceConfigureData: "test-provider-configure-value",
@@ -203,6 +243,52 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testStateUnchanged,
 			},
 		},
+		"response-diagnostics-deprecation": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSourceWithDeprecation{
+					DataSource: &testprovider.DataSource{
+						ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {},
+					},
+					DeprecationMessageMethod: func(ctx context.Context) string {
+						return "Use examplecloud_thing_v2 instead."
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Data Source Deprecated",
+						"Use examplecloud_thing_v2 instead.",
+					),
+				},
+				State: testStateUnchanged,
+			},
+		},
+		"response-diagnostics-deprecation-empty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSourceWithDeprecation{
+					DataSource: &testprovider.DataSource{
+						ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {},
+					},
+					DeprecationMessageMethod: func(ctx context.Context) string {
+						return ""
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testStateUnchanged,
+			},
+		},
 		"response-diagnostics": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -37,6 +37,12 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		return
 	}
 
+	resp.Diagnostics.Append(providerMetaValidate(ctx, req.ProviderMeta)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if req.CurrentState == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Read Request",
@@ -116,6 +122,22 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		return
 	}
 
+	// Preserve the prior state representation of empty or null values for
+	// attributes which treat them as equivalent.
+	treatEmptyAsNullData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         resp.NewState.Schema,
+		TerraformValue: resp.NewState.Raw,
+	}
+
+	resp.Diagnostics.Append(treatEmptyAsNullData.TransformTreatEmptyAsNull(ctx, req.CurrentState.Raw)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.NewState.Raw = treatEmptyAsNullData.TerraformValue
+
 	semanticEqualityReq := SchemaSemanticEqualityRequest{
 		PriorData: fwschemadata.Data{
 			Description:    fwschemadata.DataDescriptionState,
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -135,6 +136,30 @@ func TestServerReadResource(t *testing.T) {
 		Provider: testEmptyProviderData,
 	}
 
+	testSchemaTypeTreatEmptyAsNull := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testSchemaTreatEmptyAsNull := func(treatEmptyAsNull bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"test_list": schema.ListAttribute{
+					ElementType:      types.StringType,
+					Optional:         true,
+					TreatEmptyAsNull: treatEmptyAsNull,
+				},
+			},
+		}
+	}
+
+	testTreatEmptyAsNullValue := func(elements interface{}) tftypes.Value {
+		return tftypes.NewValue(testSchemaTypeTreatEmptyAsNull, map[string]tftypes.Value{
+			"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements),
+		})
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ReadResourceRequest
@@ -471,6 +496,102 @@ func TestServerReadResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-state-treat-empty-as-null-state-empty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), types.ListNull(types.StringType))...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					// The prior state representation should be kept.
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-treat-empty-as-null-state-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), []string{})...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					// The prior state representation should be kept.
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-treat-empty-as-null-disabled-state-empty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), types.ListNull(types.StringType))...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					// The response representation should be kept.
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-treat-empty-as-null-disabled-state-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), []string{})...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					// The response representation should be kept.
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestServerDataSource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		typeName         string
+		expectedTypeName string
+		expectedDiags    diag.Diagnostics
+	}{
+		"datasources": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+						return []func() datasource.DataSource{
+							testDataSourceFunc("test_data_source1"),
+							testDataSourceFunc("test_data_source2"),
+						}
+					},
+				},
+			},
+			typeName:         "test_data_source2",
+			expectedTypeName: "test_data_source2",
+		},
+		"datasources-not-found": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+						return []func() datasource.DataSource{
+							testDataSourceFunc("test_data_source1"),
+						}
+					},
+				},
+			},
+			typeName: "test_data_source2",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Data Source Type Not Found",
+					"No data source type named \"test_data_source2\" was found in the provider.",
+				),
+			},
+		},
+		"datasourcebytypename": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDataSourceByTypeName{
+					Provider: &testprovider.Provider{
+						DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+							panic("DataSources should not be called")
+						},
+					},
+					DataSourceByTypeNameMethod: func(_ context.Context, typeName string) func() datasource.DataSource {
+						if typeName != "test_data_source" {
+							return nil
+						}
+
+						return testDataSourceFunc("test_data_source")
+					},
+				},
+			},
+			typeName:         "test_data_source",
+			expectedTypeName: "test_data_source",
+		},
+		"datasourcebytypename-not-found": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDataSourceByTypeName{
+					Provider: &testprovider.Provider{
+						DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+							panic("DataSources should not be called")
+						},
+					},
+					DataSourceByTypeNameMethod: func(_ context.Context, _ string) func() datasource.DataSource {
+						return nil
+					},
+				},
+			},
+			typeName: "test_data_source",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Data Source Type Not Found",
+					"No data source type named \"test_data_source\" was found in the provider.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.server.DataSource(context.Background(), testCase.typeName)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			var gotTypeName string
+
+			if got != nil {
+				resp := &datasource.MetadataResponse{}
+
+				got.Metadata(context.Background(), datasource.MetadataRequest{}, resp)
+
+				gotTypeName = resp.TypeName
+			}
+
+			if diff := cmp.Diff(gotTypeName, testCase.expectedTypeName); diff != "" {
+				t.Errorf("unexpected type name difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		typeName         string
+		expectedTypeName string
+		expectedDiags    diag.Diagnostics
+	}{
+		"resources": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							testResourceFunc("test_resource1"),
+							testResourceFunc("test_resource2"),
+						}
+					},
+				},
+			},
+			typeName:         "test_resource2",
+			expectedTypeName: "test_resource2",
+		},
+		"resources-not-found": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							testResourceFunc("test_resource1"),
+						}
+					},
+				},
+			},
+			typeName: "test_resource2",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Type Not Found",
+					"No resource type named \"test_resource2\" was found in the provider.",
+				),
+			},
+		},
+		"resourcebytypename": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithResourceByTypeName{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							panic("Resources should not be called")
+						},
+					},
+					ResourceByTypeNameMethod: func(_ context.Context, typeName string) func() resource.Resource {
+						if typeName != "test_resource" {
+							return nil
+						}
+
+						return testResourceFunc("test_resource")
+					},
+				},
+			},
+			typeName:         "test_resource",
+			expectedTypeName: "test_resource",
+		},
+		"resourcebytypename-not-found": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithResourceByTypeName{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							panic("Resources should not be called")
+						},
+					},
+					ResourceByTypeNameMethod: func(_ context.Context, _ string) func() resource.Resource {
+						return nil
+					},
+				},
+			},
+			typeName: "test_resource",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Type Not Found",
+					"No resource type named \"test_resource\" was found in the provider.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.server.Resource(context.Background(), testCase.typeName)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			var gotTypeName string
+
+			if got != nil {
+				resp := &resource.MetadataResponse{}
+
+				got.Metadata(context.Background(), resource.MetadataRequest{}, resp)
+
+				gotTypeName = resp.TypeName
+			}
+
+			if diff := cmp.Diff(gotTypeName, testCase.expectedTypeName); diff != "" {
+				t.Errorf("unexpected type name difference: %s", diff)
+			}
+		})
+	}
+}
+
+func BenchmarkServerResource1000(b *testing.B) {
+	benchmarkServerResource(b, 1000, false)
+}
+
+func BenchmarkServerResourceByTypeName1000(b *testing.B) {
+	benchmarkServerResource(b, 1000, true)
+}
+
+// benchmarkServerResource measures looking up a single resource on a new
+// Server, such as the first ReadResource RPC of a provider process, with a
+// provider of the given number of resources.
+func benchmarkServerResource(b *testing.B, resources int, byTypeName bool) {
+	ctx := context.Background()
+	resourceFuncs := make([]func() resource.Resource, 0, resources)
+	resourceFuncsByTypeName := make(map[string]func() resource.Resource, resources)
+
+	for i := 0; i < resources; i++ {
+		typeName := fmt.Sprintf("test_resource%d", i)
+		resourceFunc := testResourceFunc(typeName)
+
+		resourceFuncs = append(resourceFuncs, resourceFunc)
+		resourceFuncsByTypeName[typeName] = resourceFunc
+	}
+
+	var p provider.Provider = &testprovider.Provider{
+		ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+			return resourceFuncs
+		},
+	}
+
+	if byTypeName {
+		p = &testprovider.ProviderWithResourceByTypeName{
+			Provider: p.(*testprovider.Provider),
+			ResourceByTypeNameMethod: func(_ context.Context, typeName string) func() resource.Resource {
+				return resourceFuncsByTypeName[typeName]
+			},
+		}
+	}
+
+	typeName := fmt.Sprintf("test_resource%d", resources-1)
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		server := &fwserver.Server{
+			Provider: p,
+		}
+
+		_, diags := server.Resource(ctx, typeName)
+
+		if diags.HasError() {
+			b.Fatalf("unexpected error diagnostics: %v", diags)
+		}
+	}
+}
+
+func testResourceFunc(typeName string) func() resource.Resource {
+	return func() resource.Resource {
+		return &testprovider.Resource{
+			MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+				resp.TypeName = typeName
+			},
+		}
+	}
+}
+
+func testDataSourceFunc(typeName string) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &testprovider.DataSource{
+			MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+				resp.TypeName = typeName
+			},
+		}
+	}
+}
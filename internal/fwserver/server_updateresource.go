@@ -44,6 +44,12 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(providerMetaValidate(ctx, req.ProviderMeta)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -145,6 +151,22 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		return
 	}
 
+	// Preserve the plan representation of empty or null values for
+	// attributes which treat them as equivalent.
+	treatEmptyAsNullData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         resp.NewState.Schema,
+		TerraformValue: resp.NewState.Raw,
+	}
+
+	resp.Diagnostics.Append(treatEmptyAsNullData.TransformTreatEmptyAsNull(ctx, req.PlannedState.Raw)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.NewState.Raw = treatEmptyAsNullData.TerraformValue
+
 	semanticEqualityReq := SchemaSemanticEqualityRequest{
 		PriorData: fwschemadata.Data{
 			Description:    fwschemadata.DataDescriptionPlan,
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -143,6 +144,30 @@ func TestServerUpdateResource(t *testing.T) {
 		Provider: testEmptyProviderData,
 	}
 
+	testSchemaTypeTreatEmptyAsNull := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testSchemaTreatEmptyAsNull := func(treatEmptyAsNull bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"test_list": schema.ListAttribute{
+					ElementType:      types.StringType,
+					Optional:         true,
+					TreatEmptyAsNull: treatEmptyAsNull,
+				},
+			},
+		}
+	}
+
+	testTreatEmptyAsNullValue := func(elements interface{}) tftypes.Value {
+		return tftypes.NewValue(testSchemaTypeTreatEmptyAsNull, map[string]tftypes.Value{
+			"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements),
+		})
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.UpdateResourceRequest
@@ -777,6 +802,138 @@ func TestServerUpdateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-newstate-treat-empty-as-null-config-empty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpdateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{tftypes.NewValue(tftypes.String, "test-old-value")}),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				ResourceSchema: testSchemaTreatEmptyAsNull(true),
+				Resource: &testprovider.Resource{
+					UpdateMethod: func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), types.ListNull(types.StringType))...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				NewState: &tfsdk.State{
+					// The plan representation should be kept.
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newstate-treat-empty-as-null-config-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpdateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{tftypes.NewValue(tftypes.String, "test-old-value")}),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				ResourceSchema: testSchemaTreatEmptyAsNull(true),
+				Resource: &testprovider.Resource{
+					UpdateMethod: func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), []string{})...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				NewState: &tfsdk.State{
+					// The plan representation should be kept.
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(true),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newstate-treat-empty-as-null-disabled-config-empty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpdateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{tftypes.NewValue(tftypes.String, "test-old-value")}),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				ResourceSchema: testSchemaTreatEmptyAsNull(false),
+				Resource: &testprovider.Resource{
+					UpdateMethod: func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), types.ListNull(types.StringType))...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				NewState: &tfsdk.State{
+					// The response representation should be kept.
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newstate-treat-empty-as-null-disabled-config-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpdateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testTreatEmptyAsNullValue(nil),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{tftypes.NewValue(tftypes.String, "test-old-value")}),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				ResourceSchema: testSchemaTreatEmptyAsNull(false),
+				Resource: &testprovider.Resource{
+					UpdateMethod: func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), []string{})...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				NewState: &tfsdk.State{
+					// The response representation should be kept.
+					Raw:    testTreatEmptyAsNullValue([]tftypes.Value{}),
+					Schema: testSchemaTreatEmptyAsNull(false),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
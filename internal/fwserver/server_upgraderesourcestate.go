@@ -5,6 +5,7 @@ package fwserver
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -124,6 +125,24 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	resourceWithUpgradeState, ok := req.Resource.(resource.ResourceWithUpgradeState)
 
 	if !ok {
+		var upgradeStateAddedAttributes bool
+
+		if resourceWithAddedAttributes, ok := req.Resource.(resource.ResourceWithUpgradeStateAddedAttributes); ok {
+			logging.FrameworkDebug(ctx, "Calling provider defined Resource UpgradeStateAddedAttributes")
+			upgradeStateAddedAttributes = resourceWithAddedAttributes.UpgradeStateAddedAttributes(ctx)
+			logging.FrameworkDebug(ctx, "Called provider defined Resource UpgradeStateAddedAttributes")
+		}
+
+		if upgradeStateAddedAttributes {
+			if upgradedState := addedAttributesUpgradedState(ctx, req); upgradedState != nil {
+				logging.FrameworkTrace(ctx, "UpgradeResourceState prior state only lacks added optional or computed attributes, using framework defined upgrade implementation")
+
+				resp.UpgradedState = upgradedState
+
+				return
+			}
+		}
+
 		resp.Diagnostics.AddError(
 			"Unable to Upgrade Resource State",
 			"This resource was implemented without an UpgradeState() method, "+
@@ -238,3 +257,67 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 	resp.UpgradedState = &upgradeResourceStateResponse.State
 }
+
+// addedAttributesUpgradedState returns the prior state read with the current
+// schema when the only schema changes since the prior state version are added
+// root attributes that are not required, otherwise nil. The added attributes
+// are set to null. This enables mechanical upgrades without a provider
+// defined StateUpgrader for resources implementing
+// ResourceWithUpgradeStateAddedAttributes. The prior state is not upgraded
+// when:
+//
+//   - The prior state version is not less than the current schema version.
+//   - The prior state is not JSON, such as Terraform 0.11 and earlier state.
+//   - No attributes were added, since the version change then likely
+//     reflects a change to the meaning of existing data.
+//   - A required attribute or block is missing from the prior state.
+//   - The prior state contains data not defined in the current schema or
+//     with an incompatible type, which likely requires custom upgrade logic.
+func addedAttributesUpgradedState(ctx context.Context, req *UpgradeResourceStateRequest) *tfsdk.State {
+	if req.Version >= req.ResourceSchema.GetVersion() || req.RawState.JSON == nil {
+		return nil
+	}
+
+	var priorState map[string]json.RawMessage
+
+	if err := json.Unmarshal(req.RawState.JSON, &priorState); err != nil {
+		return nil
+	}
+
+	var addedAttributes int
+
+	for name, attribute := range req.ResourceSchema.GetAttributes() {
+		if _, ok := priorState[name]; ok {
+			continue
+		}
+
+		if attribute.IsRequired() {
+			return nil
+		}
+
+		addedAttributes++
+	}
+
+	if addedAttributes == 0 {
+		return nil
+	}
+
+	for name := range req.ResourceSchema.GetBlocks() {
+		if _, ok := priorState[name]; !ok {
+			return nil
+		}
+	}
+
+	// Unlike the passthrough implementation, undefined attributes are not
+	// ignored as that could silently drop prior state data.
+	rawStateValue, err := req.RawState.Unmarshal(req.ResourceSchema.Type().TerraformType(ctx))
+
+	if err != nil {
+		return nil
+	}
+
+	return &tfsdk.State{
+		Schema: req.ResourceSchema,
+		Raw:    rawStateValue,
+	}
+}
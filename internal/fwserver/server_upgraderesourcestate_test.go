@@ -342,6 +342,147 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"ResourceType-UpgradeState-not-implemented-added-optional-attribute": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"required_attribute": "test-required-value",
+				}),
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+				Version:        0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"This resource was implemented without an UpgradeState() method, "+
+							"however Terraform was expecting an implementation for version 0 upgrade.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"ResourceType-UpgradeStateAddedAttributes-added-optional-attribute": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"required_attribute": "test-required-value",
+				}),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUpgradeStateAddedAttributes{
+					Resource: &testprovider.Resource{},
+					UpgradeStateAddedAttributesMethod: func(_ context.Context) bool {
+						return true
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "test-required-value"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"ResourceType-UpgradeStateAddedAttributes-disabled": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"required_attribute": "test-required-value",
+				}),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUpgradeStateAddedAttributes{
+					Resource: &testprovider.Resource{},
+					UpgradeStateAddedAttributesMethod: func(_ context.Context) bool {
+						return false
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"This resource was implemented without an UpgradeState() method, "+
+							"however Terraform was expecting an implementation for version 0 upgrade.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"ResourceType-UpgradeStateAddedAttributes-added-required-attribute": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"optional_attribute": "test-optional-value",
+				}),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUpgradeStateAddedAttributes{
+					Resource: &testprovider.Resource{},
+					UpgradeStateAddedAttributesMethod: func(_ context.Context) bool {
+						return true
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"This resource was implemented without an UpgradeState() method, "+
+							"however Terraform was expecting an implementation for version 0 upgrade.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"ResourceType-UpgradeStateAddedAttributes-no-added-attributes": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"optional_attribute": "test-optional-value",
+					"required_attribute": "test-required-value",
+				}),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUpgradeStateAddedAttributes{
+					Resource: &testprovider.Resource{},
+					UpgradeStateAddedAttributesMethod: func(_ context.Context) bool {
+						return true
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"This resource was implemented without an UpgradeState() method, "+
+							"however Terraform was expecting an implementation for version 0 upgrade.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
 		"ResourceType-UpgradeState-not-implemented": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -395,6 +536,35 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"ResourceType-UpgradeState-empty-added-optional-attribute": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"required_attribute": "test-required-value",
+				}),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUpgradeState{
+					Resource: &testprovider.Resource{},
+					UpgradeStateMethod: func(ctx context.Context) map[int64]resource.StateUpgrader {
+						return nil
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"This resource was implemented with an UpgradeState() method, "+
+							"however Terraform was expecting an implementation for version 0 upgrade.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
 		"PriorSchema-incorrect": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -17,6 +17,7 @@ import (
 type ValidateDataSourceConfigRequest struct {
 	Config     *tfsdk.Config
 	DataSource datasource.DataSource
+	TypeName   string
 }
 
 // ValidateDataSourceConfigResponse is the framework server response for the
@@ -97,7 +98,9 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:            *req.Config,
+		SkipUnknownValues: s.skipUnknownValueValidation(ctx),
+		TypeName:          req.TypeName,
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
@@ -5,6 +5,7 @@ package fwserver_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -114,6 +115,67 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{},
 		},
+		"request-config-DataSourceWithConfigure-ProviderData": {
+			server: &fwserver.Server{
+				Provider:                &testprovider.Provider{},
+				DataSourceConfigureData: "test-provider-configure-value",
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfig,
+				DataSource: &testprovider.DataSourceWithConfigure{
+					ConfigureMethod: func(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+						providerData, ok := req.ProviderData.(string)
+
+						if !ok {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected string, got: %T", req.ProviderData),
+							)
+							return
+						}
+
+						if providerData != "test-provider-configure-value" {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected test-provider-configure-value, got: %q", providerData),
+							)
+						}
+					},
+					DataSource: &testprovider.DataSource{
+						SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{},
+		},
+		"request-config-DataSourceWithConfigure-ProviderData-nil": {
+			// Terraform can validate configuration before the provider
+			// is configured, so the provider data may not be available.
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfig,
+				DataSource: &testprovider.DataSourceWithConfigure{
+					ConfigureMethod: func(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+						if req.ProviderData != nil {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected nil, got: %T", req.ProviderData),
+							)
+						}
+					},
+					DataSource: &testprovider.DataSource{
+						SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{},
+		},
 		"request-config-AttributeValidator": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -78,7 +78,8 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:            *req.Config,
+		SkipUnknownValues: s.skipUnknownValueValidation(ctx),
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
@@ -17,6 +17,7 @@ import (
 type ValidateResourceConfigRequest struct {
 	Config   *tfsdk.Config
 	Resource resource.Resource
+	TypeName string
 }
 
 // ValidateResourceConfigResponse is the framework server response for the
@@ -97,7 +98,9 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:            *req.Config,
+		SkipUnknownValues: s.skipUnknownValueValidation(ctx),
+		TypeName:          req.TypeName,
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
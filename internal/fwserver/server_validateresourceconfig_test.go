@@ -5,6 +5,7 @@ package fwserver_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -89,6 +90,33 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testConfigAttributeValidatorErrorUnknown := tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+		Schema: testSchemaAttributeValidatorError,
+	}
+
+	testSchemaAttributeValidatorTypeName := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail for "+req.TypeName)
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testConfigAttributeValidatorTypeName := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaAttributeValidatorTypeName,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateResourceConfigRequest
@@ -114,6 +142,67 @@ func TestServerValidateResourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
 		},
+		"request-config-ResourceWithConfigure-ProviderData": {
+			server: &fwserver.Server{
+				Provider:              &testprovider.Provider{},
+				ResourceConfigureData: "test-provider-configure-value",
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithConfigure{
+					ConfigureMethod: func(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+						providerData, ok := req.ProviderData.(string)
+
+						if !ok {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected string, got: %T", req.ProviderData),
+							)
+							return
+						}
+
+						if providerData != "test-provider-configure-value" {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected test-provider-configure-value, got: %q", providerData),
+							)
+						}
+					},
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-ResourceWithConfigure-ProviderData-nil": {
+			// Terraform can validate configuration before the provider
+			// is configured, so the provider data may not be available.
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithConfigure{
+					ConfigureMethod: func(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+						if req.ProviderData != nil {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected nil, got: %T", req.ProviderData),
+							)
+						}
+					},
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
 		"request-config-AttributeValidator": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -150,6 +239,124 @@ func TestServerValidateResourceConfig(t *testing.T) {
 				},
 			},
 		},
+		"request-config-AttributeValidator-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigAttributeValidatorErrorUnknown,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeValidatorError
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"error summary",
+						"error detail",
+					),
+				},
+			},
+		},
+		"request-config-AttributeValidator-ProviderWithValidateUnknownValues-false-known": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithValidateUnknownValues{
+					Provider: &testprovider.Provider{},
+					ValidateUnknownValuesMethod: func(_ context.Context) bool {
+						return false
+					},
+				},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigAttributeValidatorError,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeValidatorError
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"error summary",
+						"error detail",
+					),
+				},
+			},
+		},
+		"request-config-AttributeValidator-ProviderWithValidateUnknownValues-false-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithValidateUnknownValues{
+					Provider: &testprovider.Provider{},
+					ValidateUnknownValuesMethod: func(_ context.Context) bool {
+						return false
+					},
+				},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigAttributeValidatorErrorUnknown,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeValidatorError
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-AttributeValidator-ProviderWithValidateUnknownValues-true-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithValidateUnknownValues{
+					Provider: &testprovider.Provider{},
+					ValidateUnknownValuesMethod: func(_ context.Context) bool {
+						return true
+					},
+				},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigAttributeValidatorErrorUnknown,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeValidatorError
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"error summary",
+						"error detail",
+					),
+				},
+			},
+		},
+		"request-config-AttributeValidator-TypeName": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigAttributeValidatorTypeName,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeValidatorTypeName
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"error summary",
+						"error detail for test_resource",
+					),
+				},
+			},
+		},
 		"request-config-ResourceWithConfigValidators": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
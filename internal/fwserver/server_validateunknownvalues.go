@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// skipUnknownValueValidation returns true if the provider implements
+// provider.ProviderWithValidateUnknownValues and has disabled calling
+// attribute and block validators with unknown configuration values.
+func (s *Server) skipUnknownValueValidation(ctx context.Context) bool {
+	providerWithValidateUnknownValues, ok := s.Provider.(provider.ProviderWithValidateUnknownValues)
+
+	if !ok {
+		return false
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithValidateUnknownValues")
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider ValidateUnknownValues")
+	validateUnknownValues := providerWithValidateUnknownValues.ValidateUnknownValues(ctx)
+	logging.FrameworkDebug(ctx, "Called provider defined Provider ValidateUnknownValues")
+
+	return !validateUnknownValues
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// terraformVersion is a parsed MAJOR.MINOR.PATCH Terraform version. Any
+// pre-release or build metadata suffix is discarded.
+type terraformVersion [3]uint64
+
+// parseTerraformVersion parses a version string, such as "1.5.0", "v1.5",
+// or "1.6.0-dev". Missing minor and patch segments are treated as zero.
+func parseTerraformVersion(raw string) (terraformVersion, error) {
+	var result terraformVersion
+
+	version := strings.TrimPrefix(strings.TrimSpace(raw), "v")
+
+	if index := strings.IndexAny(version, "-+"); index != -1 {
+		version = version[:index]
+	}
+
+	segments := strings.Split(version, ".")
+
+	if len(segments) > len(result) {
+		return result, fmt.Errorf("version %q contains more than %d segments", raw, len(result))
+	}
+
+	for i, segment := range segments {
+		value, err := strconv.ParseUint(segment, 10, 64)
+
+		if err != nil {
+			return result, fmt.Errorf("version %q contains invalid segment %q", raw, segment)
+		}
+
+		result[i] = value
+	}
+
+	return result, nil
+}
+
+// LessThan returns true if the version is earlier than the other version.
+func (v terraformVersion) LessThan(other terraformVersion) bool {
+	for i := range v {
+		if v[i] != other[i] {
+			return v[i] < other[i]
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// validatorSkipApplyOnly returns true if the validator implements
+// validator.ApplyOnly, returns true from its ApplyOnly method, and the
+// configuration value is not wholly known.
+func validatorSkipApplyOnly(ctx context.Context, v any, configValue attr.Value) bool {
+	applyOnlyValidator, ok := v.(validator.ApplyOnly)
+
+	if !ok || !applyOnlyValidator.ApplyOnly(ctx) {
+		return false
+	}
+
+	if configValue == nil || configValue.IsUnknown() {
+		return true
+	}
+
+	tfValue, err := configValue.ToTerraformValue(ctx)
+
+	// Leave any conversion errors to be handled by the validator.
+	if err != nil {
+		return false
+	}
+
+	return !tfValue.IsFullyKnown()
+}
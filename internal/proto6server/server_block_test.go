@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestServerReadResourceSingleNestedBlock verifies an absent single nested
+// block is read as null, while a block which is present with only null
+// attribute values is read as a known object, when round-tripped through the
+// protocol state.
+func TestServerReadResourceSingleNestedBlock(t *testing.T) {
+	t.Parallel()
+
+	testBlockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_block": testBlockType,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"test_block": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"test_attribute": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	type testSchemaData struct {
+		TestBlock types.Object `tfsdk:"test_block"`
+	}
+
+	testCases := map[string]struct {
+		currentState     *tfprotov6.DynamicValue
+		expectedNull     bool
+		expectedNewState *tfprotov6.DynamicValue
+	}{
+		"block-absent": {
+			currentState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+				"test_block": tftypes.NewValue(testBlockType, nil),
+			}),
+			expectedNull: true,
+			expectedNewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+				"test_block": tftypes.NewValue(testBlockType, nil),
+			}),
+		},
+		"block-present-empty": {
+			currentState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+				"test_block": tftypes.NewValue(testBlockType, map[string]tftypes.Value{
+					"test_attribute": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+			expectedNull: false,
+			expectedNewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+				"test_block": tftypes.NewValue(testBlockType, map[string]tftypes.Value{
+					"test_attribute": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+										ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+											var data testSchemaData
+
+											resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+											if data.TestBlock.IsNull() != testCase.expectedNull {
+												resp.Diagnostics.AddError(
+													"Unexpected Block Value",
+													"Got: "+data.TestBlock.String(),
+												)
+											}
+
+											resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			}
+
+			got, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+				CurrentState: testCase.currentState,
+				TypeName:     "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", got.Diagnostics)
+			}
+
+			if diff := cmp.Diff(got.NewState, testCase.expectedNewState); diff != "" {
+				t.Errorf("unexpected new state difference: %s", diff)
+			}
+		})
+	}
+}
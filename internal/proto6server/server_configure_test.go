@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// TestServerConfigureProviderData verifies the provider ConfigureResponse
+// ResourceData is passed to resources and DataSourceData is passed to data
+// sources in subsequent RPCs.
+func TestServerConfigureProviderData(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testConfig := testNewDynamicValue(t, testType, map[string]tftypes.Value{
+		"test": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testEmptyConfig := testNewDynamicValue(t, tftypes.Object{}, map[string]tftypes.Value{})
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, _ *provider.SchemaResponse) {},
+				ConfigureMethod: func(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+					resp.DataSourceData = "test-datasource-data"
+					resp.ResourceData = "test-resource-data"
+				},
+				DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+					return []func() datasource.DataSource{
+						func() datasource.DataSource {
+							return &testprovider.DataSourceWithConfigure{
+								DataSource: &testprovider.DataSource{
+									SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+										resp.Schema = datasourceschema.Schema{
+											Attributes: map[string]datasourceschema.Attribute{
+												"test": datasourceschema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+										resp.TypeName = "test_data_source"
+									},
+								},
+								ConfigureMethod: func(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+									if req.ProviderData != "test-datasource-data" {
+										resp.Diagnostics.AddError("Unexpected req.ProviderData", fmt.Sprintf("Got: %v", req.ProviderData))
+									}
+								},
+							}
+						},
+					}
+				},
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.ResourceWithConfigure{
+								Resource: &testprovider.Resource{
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+										resp.Schema = resourceschema.Schema{
+											Attributes: map[string]resourceschema.Attribute{
+												"test": resourceschema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+									},
+								},
+								ConfigureMethod: func(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+									if req.ProviderData != "test-resource-data" {
+										resp.Diagnostics.AddError("Unexpected req.ProviderData", fmt.Sprintf("Got: %v", req.ProviderData))
+									}
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	configureResp, err := server.ConfigureProvider(context.Background(), &tfprotov6.ConfigureProviderRequest{
+		Config: testEmptyConfig,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ConfigureProvider error: %s", err)
+	}
+
+	if len(configureResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected ConfigureProvider diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	dataSourceResp, err := server.ValidateDataResourceConfig(context.Background(), &tfprotov6.ValidateDataResourceConfigRequest{
+		Config:   testConfig,
+		TypeName: "test_data_source",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ValidateDataResourceConfig error: %s", err)
+	}
+
+	if len(dataSourceResp.Diagnostics) > 0 {
+		t.Errorf("unexpected ValidateDataResourceConfig diagnostics: %v", dataSourceResp.Diagnostics)
+	}
+
+	resourceResp, err := server.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+		Config:   testConfig,
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ValidateResourceConfig error: %s", err)
+	}
+
+	if len(resourceResp.Diagnostics) > 0 {
+		t.Errorf("unexpected ValidateResourceConfig diagnostics: %v", resourceResp.Diagnostics)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestServerPrivateCreateRead verifies provider-defined private state data
+// set during Create is returned to the provider during a subsequent Read,
+// when round-tripped through the protocol private state bytes.
+func TestServerPrivateCreateRead(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testEmptyDynamicValue, _ := tfprotov6.NewDynamicValue(testSchemaType, tftypes.NewValue(testSchemaType, nil))
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	type testSchemaData struct {
+		TestRequired types.String `tfsdk:"test_required"`
+	}
+
+	testState := testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+		"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+	})
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = testSchema
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+								CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+									var data testSchemaData
+
+									resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+									resp.Diagnostics.Append(resp.Private.SetKey(ctx, "providerKey", []byte(`{"cursor": "test-cursor"}`))...)
+									resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+								},
+								ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+									got, diags := req.Private.GetKey(ctx, "providerKey")
+
+									resp.Diagnostics.Append(diags...)
+
+									if string(got) != `{"cursor": "test-cursor"}` {
+										resp.Diagnostics.AddError("Unexpected req.Private Value", "Got: "+string(got))
+									}
+								},
+								DeleteMethod: func(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+									resp.Diagnostics.AddError("Unexpected Method Call", "Expected: Create, Got: Delete")
+								},
+								UpdateMethod: func(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+									resp.Diagnostics.AddError("Unexpected Method Call", "Expected: Create, Got: Update")
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	applyResp, err := server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		Config:       testState,
+		PlannedState: testState,
+		PriorState:   &testEmptyDynamicValue,
+		TypeName:     "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ApplyResourceChange error: %s", err)
+	}
+
+	if len(applyResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected ApplyResourceChange diagnostics: %v", applyResp.Diagnostics)
+	}
+
+	expectedPrivate := []byte(`{"providerKey":"eyJjdXJzb3IiOiAidGVzdC1jdXJzb3IifQ=="}`)
+
+	if diff := cmp.Diff(applyResp.Private, expectedPrivate); diff != "" {
+		t.Errorf("unexpected ApplyResourceChange private difference: %s", diff)
+	}
+
+	readResp, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		CurrentState: applyResp.NewState,
+		Private:      applyResp.Private,
+		TypeName:     "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ReadResource error: %s", err)
+	}
+
+	if len(readResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected ReadResource diagnostics: %v", readResp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(readResp.Private, expectedPrivate); diff != "" {
+		t.Errorf("unexpected ReadResource private difference: %s", diff)
+	}
+}
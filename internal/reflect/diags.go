@@ -17,7 +17,7 @@ func toTerraform5ValueErrorDiag(err error, path path.Path) diag.DiagnosticWithPa
 	return diag.NewAttributeErrorDiagnostic(
 		path,
 		"Value Conversion Error",
-		"An unexpected error was encountered trying to convert into a Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		"An unexpected error was encountered trying to convert into a Terraform value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 	)
 }
 
@@ -25,7 +25,7 @@ func toTerraformValueErrorDiag(err error, path path.Path) diag.DiagnosticWithPat
 	return diag.NewAttributeErrorDiagnostic(
 		path,
 		"Value Conversion Error",
-		"An unexpected error was encountered trying to convert the Attribute value into a Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		"An unexpected error was encountered trying to convert the Attribute value into a Terraform value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 	)
 }
 
@@ -33,7 +33,7 @@ func validateValueErrorDiag(err error, path path.Path) diag.DiagnosticWithPath {
 	return diag.NewAttributeErrorDiagnostic(
 		path,
 		"Value Conversion Error",
-		"An unexpected error was encountered trying to validate the Terraform value type. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		"An unexpected error was encountered trying to validate the Terraform value type. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 	)
 }
 
@@ -41,7 +41,7 @@ func valueFromTerraformErrorDiag(err error, path path.Path) diag.DiagnosticWithP
 	return diag.NewAttributeErrorDiagnostic(
 		path,
 		"Value Conversion Error",
-		"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 	)
 }
 
@@ -60,7 +60,7 @@ func (d DiagIntoIncompatibleType) Summary() string {
 }
 
 func (d DiagIntoIncompatibleType) Detail() string {
-	return fmt.Sprintf("An unexpected error was encountered trying to convert %T into %s. This is always an error in the provider. Please report the following to the provider developer:\n\n%s", d.Val, d.TargetType, d.Err.Error())
+	return fmt.Sprintf("An unexpected error was encountered trying to convert %T into %s. This is always an error in the provider. %s\n\n%s", d.Val, d.TargetType, diag.ProviderDeveloperReportMessage, d.Err.Error())
 }
 
 func (d DiagIntoIncompatibleType) Equal(o diag.Diagnostic) bool {
@@ -95,7 +95,7 @@ func (d DiagNewAttributeValueIntoWrongType) Summary() string {
 }
 
 func (d DiagNewAttributeValueIntoWrongType) Detail() string {
-	return fmt.Sprintf("An unexpected error was encountered trying to convert into a Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\nCannot use attr.Value %s, only %s is supported because %T is the type in the schema", d.TargetType, d.ValType, d.SchemaType)
+	return fmt.Sprintf("An unexpected error was encountered trying to convert into a Terraform value. This is always an error in the provider. %s\n\nCannot use attr.Value %s, only %s is supported because %T is the type in the schema", diag.ProviderDeveloperReportMessage, d.TargetType, d.ValType, d.SchemaType)
 }
 
 func (d DiagNewAttributeValueIntoWrongType) Equal(o diag.Diagnostic) bool {
@@ -37,7 +37,7 @@ func NewUnknownable(ctx context.Context, typ attr.Type, val tftypes.Value, targe
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to convert value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return target, diags
 	}
@@ -58,7 +58,7 @@ func NewUnknownable(ctx context.Context, typ attr.Type, val tftypes.Value, targe
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+underlyingErr.Error(),
+			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+underlyingErr.Error(),
 		)
 		return target, diags
 	}
@@ -131,7 +131,7 @@ func NewNullable(ctx context.Context, typ attr.Type, val tftypes.Value, target r
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to convert value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return target, diags
 	}
@@ -152,7 +152,7 @@ func NewNullable(ctx context.Context, typ attr.Type, val tftypes.Value, target r
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+underlyingErr.Error(),
+			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+underlyingErr.Error(),
 		)
 		return target, diags
 	}
@@ -218,7 +218,7 @@ func NewValueConverter(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return target, diags
 	}
@@ -236,7 +236,7 @@ func NewValueConverter(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+underlyingErr.Error(),
+			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+underlyingErr.Error(),
 		)
 		return target, diags
 	}
@@ -328,7 +328,7 @@ func FromAttributeValue(ctx context.Context, typ attr.Type, val attr.Value, path
 			path,
 			"Value Conversion Error",
 			"An unexpected error was encountered while verifying an attribute value matched its expected type to prevent unexpected behavior or panics. "+
-				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				fmt.Sprintf("Expected type: %s\n", typ)+
 				fmt.Sprintf("Value type: %s\n", val.Type(ctx))+
 				fmt.Sprintf("Path: %s", path),
@@ -35,7 +35,7 @@ func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interfac
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			fmt.Sprintf("An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\nPath: %s\nError: %s", path.String(), err.Error()),
+			fmt.Sprintf("An unexpected error was encountered trying to convert the value. This is always an error in the provider. %s\n\nPath: %s\nError: %s", diag.ProviderDeveloperReportMessage, path.String(), err.Error()),
 		)
 		return diags
 	}
@@ -62,7 +62,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to build a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return target, diags
 	}
@@ -117,7 +117,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 			diags.AddAttributeError(
 				path,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"An unexpected error was encountered trying to build a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 					"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
 					fmt.Sprintf("Path: %s\nTarget Type: %s\nSuggested Type: %s", path.String(), target.Type(), reflect.TypeOf(typ.ValueType(ctx))),
 			)
@@ -141,7 +141,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to build a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
 				fmt.Sprintf("Path: %s\nTarget Type: %s\nSuggested `types` Type: %s\nSuggested Pointer Type: *%s", path.String(), target.Type(), reflect.TypeOf(typ.ValueType(ctx)), target.Type()),
 		)
@@ -191,7 +191,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to build a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return target, diags
 	}
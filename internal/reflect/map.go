@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
@@ -68,9 +69,24 @@ func Map(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.V
 	// we want an empty version of the map
 	m := reflect.MakeMapWithSize(underlyingValue.Type(), len(values))
 
+	// iterate keys in sorted order so any diagnostics are deterministic
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	// track whether any element failed to convert when collecting all
+	// element errors
+	var elemErrors bool
+
 	// go over each of the values passed in, create a Go value of the right
 	// type for them, and add it to our new map
-	for key, value := range values {
+	for _, key := range keys {
+		value := values[key]
+
 		// create a new Go value of the type that can go in the map
 		targetValue := reflect.Zero(elemType)
 
@@ -81,26 +97,38 @@ func Map(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.V
 		result, elemDiags := BuildValue(ctx, elemAttrType, value, targetValue, opts, path)
 		diags.Append(elemDiags...)
 
-		if diags.HasError() {
+		if elemDiags.HasError() {
+			if opts.CollectAllElementErrors {
+				elemErrors = true
+
+				continue
+			}
+
 			return target, diags
 		}
 
 		m.SetMapIndex(reflect.ValueOf(key), result)
 	}
 
+	if elemErrors {
+		return target, diags
+	}
+
 	return m, diags
 }
 
 // FromMap returns an attr.Value representing the data contained in `val`.
 // `val` must be a map type with keys that are a string type. The attr.Value
-// will be of the type produced by `typ`.
+// will be of the type produced by `typ`. If the map is nil, or empty and the
+// EmptyAsNull option is enabled, the representation of null for `typ` will be
+// returned.
 //
 // It is meant to be called through FromValue, not directly.
-func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Value, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	tfType := typ.TerraformType(ctx)
 
-	if val.IsNil() {
+	if val.IsNil() || (opts.EmptyAsNull && val.Len() == 0) {
 		tfVal := tftypes.NewValue(tfType, nil)
 
 		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
@@ -117,7 +145,7 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 			diags.AddAttributeError(
 				path,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to convert from map value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert from map value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			)
 			return nil, diags
 		}
@@ -133,11 +161,11 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 			diags.AddAttributeError(
 				path,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to convert into a Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert into a Terraform value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			)
 			return nil, diags
 		}
-		val, valDiags := FromValue(ctx, elemType, val.MapIndex(key).Interface(), path.AtMapKey(key.String()))
+		val, valDiags := FromValue(ctx, elemType, val.MapIndex(key).Interface(), opts, path.AtMapKey(key.String()))
 		diags.Append(valDiags...)
 
 		if diags.HasError() {
@@ -180,7 +208,7 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert to map value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to convert to map value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return nil, diags
 	}
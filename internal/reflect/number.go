@@ -45,7 +45,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 	roundingErrorDiag := diag.NewAttributeErrorDiagnostic(
 		path,
 		"Value Conversion Error",
-		"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+roundingError.Error(),
+		"An unexpected error was encountered trying to convert to number. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+roundingError.Error(),
 	)
 
 	switch target.Type() {
@@ -180,7 +180,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			diags.AddAttributeError(
 				path,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert to number. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			)
 			return target, diags
 		}
@@ -200,7 +200,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 				diags.AddAttributeError(
 					path,
 					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+					"An unexpected error was encountered trying to convert to number. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 				)
 				return target, diags
 			}
@@ -214,7 +214,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 				diags.AddAttributeError(
 					path,
 					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+					"An unexpected error was encountered trying to convert to number. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 				)
 				return target, diags
 			}
@@ -223,7 +223,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			diags.AddAttributeError(
 				path,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert to number. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			)
 			return target, diags
 		}
@@ -233,7 +233,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 	diags.AddAttributeError(
 		path,
 		"Value Conversion Error",
-		"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		"An unexpected error was encountered trying to convert to number. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 	)
 	return target, diags
 }
@@ -742,6 +742,30 @@ func TestNumber_uint16OverflowError(t *testing.T) {
 	}
 }
 
+//nolint:paralleltest // Modifies diag.ProviderDeveloperReportMessage
+func TestNumber_uint16OverflowErrorCustomReportMessage(t *testing.T) {
+	diag.ProviderDeveloperReportMessage = "Please report the following at https://example.com/issues:"
+
+	t.Cleanup(func() {
+		diag.ProviderDeveloperReportMessage = diag.DefaultProviderDeveloperReportMessage
+	})
+
+	var n uint16
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Empty(),
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following at https://example.com/issues:\n\ncannot store 65536 in uint16",
+		),
+	}
+
+	_, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, math.MaxUint16+1), reflect.ValueOf(n), refl.Options{}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+}
+
 func TestNumber_uint16Underflow(t *testing.T) {
 	t.Parallel()
 
@@ -20,4 +20,29 @@ type Options struct {
 	// perfectly in the types they're being stored in, rather than
 	// returning errors. Numbers will always be rounded towards 0.
 	AllowRoundingNumbers bool
+
+	// EmptyAsNull controls whether empty slices and maps should be
+	// translated into null values, rather than empty values, when
+	// converting from Go values. This setting is only used by FromValue.
+	EmptyAsNull bool
+
+	// CollectAllElementErrors controls whether converting list, map, and
+	// set elements into Go values continues after an element returns error
+	// diagnostics, so the diagnostics of every invalid element are returned,
+	// rather than stopping at the first invalid element. This setting is only
+	// used by Into.
+	CollectAllElementErrors bool
+}
+
+// NewOptions returns the Options after applying each of the given option
+// functions in order. This is the single conversion of the public value
+// creation options, such as basetypes.ValueFromOption, into Options.
+func NewOptions[T ~func(*Options)](opts ...T) Options {
+	var result Options
+
+	for _, opt := range opts {
+		opt(&result)
+	}
+
+	return result
 }
@@ -19,7 +19,7 @@ import (
 // into an attr.Value using the attr.Type supplied. `val` will first be
 // transformed into a tftypes.Value, then passed to `typ`'s ValueFromTerraform
 // method.
-func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Path) (attr.Value, diag.Diagnostics) {
+func FromValue(ctx context.Context, typ attr.Type, val interface{}, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if v, ok := val.(attr.Value); ok {
@@ -50,11 +50,11 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 			diags.AddAttributeError(
 				path,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert from value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			)
 			return nil, diags
 		}
-		return FromStruct(ctx, t, value, path)
+		return FromStruct(ctx, t, value, opts, path)
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
 		reflect.Int64:
 		return FromInt(ctx, typ, value.Int(), path)
@@ -68,7 +68,7 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 	case reflect.String:
 		return FromString(ctx, typ, value.String(), path)
 	case reflect.Slice:
-		return FromSlice(ctx, typ, value, path)
+		return FromSlice(ctx, typ, value, opts, path)
 	case reflect.Map:
 		t, ok := typ.(attr.TypeWithElementType)
 		if !ok {
@@ -76,19 +76,19 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 			diags.AddAttributeError(
 				path,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert from value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			)
 			return nil, diags
 		}
-		return FromMap(ctx, t, value, path)
+		return FromMap(ctx, t, value, opts, path)
 	case reflect.Ptr:
-		return FromPointer(ctx, typ, value, path)
+		return FromPointer(ctx, typ, value, opts, path)
 	default:
 		err := fmt.Errorf("cannot construct attr.Type from %T (%s)", val, kind)
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to convert from value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return nil, diags
 	}
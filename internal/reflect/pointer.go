@@ -78,7 +78,7 @@ func pointerSafeZeroValue(_ context.Context, target reflect.Value) reflect.Value
 // the pointer is referencing.
 //
 // It is meant to be called through FromValue, not directly.
-func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if value.Kind() != reflect.Ptr {
@@ -86,7 +86,7 @@ func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path p
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert from pointer value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to convert from pointer value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return nil, diags
 	}
@@ -107,7 +107,7 @@ func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path p
 			diags.AddAttributeError(
 				path,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to convert from pointer value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert from pointer value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			)
 			return nil, diags
 		}
@@ -115,7 +115,7 @@ func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path p
 		return attrVal, diags
 	}
 
-	attrVal, attrValDiags := FromValue(ctx, typ, value.Elem().Interface(), path)
+	attrVal, attrValDiags := FromValue(ctx, typ, value.Elem().Interface(), opts, path)
 	diags.Append(attrValDiags...)
 
 	return attrVal, diags
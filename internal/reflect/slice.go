@@ -60,6 +60,10 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 	// we want an empty version of the slice
 	slice := reflect.MakeSlice(target.Type(), 0, len(values))
 
+	// track whether any element failed to convert when collecting all
+	// element errors
+	var elemErrors bool
+
 	// go over each of the values passed in, create a Go value of the right
 	// type for them, and add it to our new slice
 	for pos, value := range values {
@@ -76,8 +80,15 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 				diags.AddAttributeError(
 					path,
 					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert to slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+					"An unexpected error was encountered trying to convert to slice value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 				)
+
+				if opts.CollectAllElementErrors {
+					elemErrors = true
+
+					continue
+				}
+
 				return target, diags
 			}
 
@@ -88,7 +99,13 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 		val, valDiags := BuildValue(ctx, elemAttrType, value, targetValue, opts, valPath)
 		diags.Append(valDiags...)
 
-		if diags.HasError() {
+		if valDiags.HasError() {
+			if opts.CollectAllElementErrors {
+				elemErrors = true
+
+				continue
+			}
+
 			return target, diags
 		}
 
@@ -96,24 +113,29 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 		slice = reflect.Append(slice, val)
 	}
 
+	if elemErrors {
+		return target, diags
+	}
+
 	return slice, diags
 }
 
 // FromSlice returns an attr.Value as produced by `typ` using the data in
 // `val`. `val` must be a slice. `typ` must be an attr.TypeWithElementType or
-// attr.TypeWithElementTypes. If the slice is nil, the representation of null
-// for `typ` will be returned. Otherwise, FromSlice will recurse into FromValue
+// attr.TypeWithElementTypes. If the slice is nil, or empty and the
+// EmptyAsNull option is enabled, the representation of null for `typ` will be
+// returned. Otherwise, FromSlice will recurse into FromValue
 // for each element in the slice, using the element type or types defined on
 // `typ` to construct values for them.
 //
 // It is meant to be called through FromValue, not directly.
-func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// TODO: support tuples, which are attr.TypeWithElementTypes
 	tfType := typ.TerraformType(ctx)
 
-	if val.IsNil() {
+	if val.IsNil() || (opts.EmptyAsNull && val.Len() == 0) {
 		tfVal := tftypes.NewValue(tfType, nil)
 
 		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
@@ -130,7 +152,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 			diags.AddAttributeError(
 				path,
 				"Value Conversion Error",
-				"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			)
 			return nil, diags
 		}
@@ -144,7 +166,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return nil, diags
 	}
@@ -159,7 +181,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 		// debugging purposes, then correct the path afterwards.
 		valPath := path.AtListIndex(i)
 
-		val, valDiags := FromValue(ctx, elemType, val.Index(i).Interface(), valPath)
+		val, valDiags := FromValue(ctx, elemType, val.Index(i).Interface(), opts, valPath)
 		diags.Append(valDiags...)
 
 		if diags.HasError() {
@@ -206,7 +228,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return nil, diags
 	}
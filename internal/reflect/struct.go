@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -148,7 +149,7 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 // reported by `typ`.
 //
 // It is meant to be called through FromValue, not directly.
-func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflect.Value, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	objTypes := map[string]tftypes.Type{}
 	objValues := map[string]tftypes.Value{}
@@ -161,7 +162,7 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 		diags.AddAttributeError(
 			path,
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return nil, diags
 	}
@@ -170,9 +171,10 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 
 	var objectMissing, structMissing []string
 
-	for field := range targetFields {
+	for field, fieldNo := range targetFields {
 		if _, ok := attrTypes[field]; !ok {
-			objectMissing = append(objectMissing, field)
+			// Include the Go field name to simplify finding the field.
+			objectMissing = append(objectMissing, fmt.Sprintf("%s (%s)", field, val.Type().Field(fieldNo).Name))
 		}
 	}
 
@@ -186,6 +188,10 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 		}
 	}
 
+	// Ensure deterministic diagnostic details.
+	sort.Strings(objectMissing)
+	sort.Strings(structMissing)
+
 	if len(objectMissing) > 0 || len(structMissing) > 0 {
 		missing := make([]string, 0, len(objectMissing)+len(structMissing))
 
@@ -201,7 +207,7 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 			path,
 			"Value Conversion Error",
 			"An unexpected error was encountered trying to convert from struct into an object. "+
-				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				fmt.Sprintf("Mismatch between struct and object type: %s\n", strings.Join(missing, " "))+
 				fmt.Sprintf("Struct: %s\n", val.Type())+
 				fmt.Sprintf("Object type: %s", typ),
@@ -214,7 +220,7 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 		path := path.AtName(name)
 		fieldValue := val.Field(fieldNo)
 
-		attrVal, attrValDiags := FromValue(ctx, attrTypes[name], fieldValue.Interface(), path)
+		attrVal, attrValDiags := FromValue(ctx, attrTypes[name], fieldValue.Interface(), opts, path)
 		diags.Append(attrValDiags...)
 
 		if diags.HasError() {
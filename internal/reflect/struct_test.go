@@ -584,7 +584,7 @@ func TestFromStruct_primitives(t *testing.T) {
 			"age":      types.NumberType,
 			"opted_in": types.BoolType,
 		},
-	}, reflect.ValueOf(disk1), path.Empty())
+	}, reflect.ValueOf(disk1), refl.Options{}, path.Empty())
 	if diags.HasError() {
 		t.Fatalf("Unexpected error: %v", diags)
 	}
@@ -737,7 +737,7 @@ func TestFromStruct_complex(t *testing.T) {
 			"big_int":         types.NumberType,
 			"uint":            types.NumberType,
 		},
-	}, reflect.ValueOf(s), path.Empty())
+	}, reflect.ValueOf(s), refl.Options{}, path.Empty())
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -963,7 +963,7 @@ func TestFromStruct_errors(t *testing.T) {
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to convert from struct into an object. "+
 						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Mismatch between struct and object type: Struct defines fields not found in object: not_test. Object defines fields not found in struct: test.\n"+
+						"Mismatch between struct and object type: Struct defines fields not found in object: not_test (NotTest). Object defines fields not found in struct: test.\n"+
 						`Struct: struct { NotTest basetypes.StringValue "tfsdk:\"not_test\"" }`+"\n"+
 						`Object type: types.ObjectType["test":basetypes.StringType]`,
 				),
@@ -1172,6 +1172,7 @@ func TestFromStruct_errors(t *testing.T) {
 				context.Background(),
 				testCase.typ,
 				testCase.val,
+				refl.Options{},
 				path.Root("test"),
 			)
 
@@ -1209,7 +1210,7 @@ func TestFromStruct_structtags_ignores(t *testing.T) {
 		AttrTypes: map[string]attr.Type{
 			"exported_and_tagged": types.StringType,
 		},
-	}, reflect.ValueOf(testStruct), path.Empty())
+	}, reflect.ValueOf(testStruct), refl.Options{}, path.Empty())
 	if diags.HasError() {
 		t.Fatalf("Unexpected error: %v", diags)
 	}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var _ datasource.DataSource = &DataSourceWithDeprecation{}
+var _ datasource.DataSourceWithDeprecation = &DataSourceWithDeprecation{}
+
+// Declarative datasource.DataSourceWithDeprecation for unit testing.
+type DataSourceWithDeprecation struct {
+	*DataSource
+
+	// DataSourceWithDeprecation interface methods
+	DeprecationMessageMethod func(context.Context) string
+}
+
+// DeprecationMessage satisfies the datasource.DataSourceWithDeprecation interface.
+func (p *DataSourceWithDeprecation) DeprecationMessage(ctx context.Context) string {
+	if p.DeprecationMessageMethod == nil {
+		return ""
+	}
+
+	return p.DeprecationMessageMethod(ctx)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithDataSourceByTypeName{}
+var _ provider.ProviderWithDataSourceByTypeName = &ProviderWithDataSourceByTypeName{}
+
+// Declarative provider.ProviderWithDataSourceByTypeName for unit testing.
+type ProviderWithDataSourceByTypeName struct {
+	*Provider
+
+	// ProviderWithDataSourceByTypeName interface methods
+	DataSourceByTypeNameMethod func(context.Context, string) func() datasource.DataSource
+}
+
+// DataSourceByTypeName satisfies the provider.ProviderWithDataSourceByTypeName interface.
+func (p *ProviderWithDataSourceByTypeName) DataSourceByTypeName(ctx context.Context, typeName string) func() datasource.DataSource {
+	if p.DataSourceByTypeNameMethod == nil {
+		return nil
+	}
+
+	return p.DataSourceByTypeNameMethod(ctx, typeName)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithMinimumTerraformVersion{}
+var _ provider.ProviderWithMinimumTerraformVersion = &ProviderWithMinimumTerraformVersion{}
+
+// Declarative provider.ProviderWithMinimumTerraformVersion for unit testing.
+type ProviderWithMinimumTerraformVersion struct {
+	*Provider
+
+	// ProviderWithMinimumTerraformVersion interface methods
+	MinimumTerraformVersionMethod func(context.Context) string
+}
+
+// MinimumTerraformVersion satisfies the provider.ProviderWithMinimumTerraformVersion interface.
+func (p *ProviderWithMinimumTerraformVersion) MinimumTerraformVersion(ctx context.Context) string {
+	if p.MinimumTerraformVersionMethod == nil {
+		return ""
+	}
+
+	return p.MinimumTerraformVersionMethod(ctx)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ provider.Provider = &ProviderWithResourceByTypeName{}
+var _ provider.ProviderWithResourceByTypeName = &ProviderWithResourceByTypeName{}
+
+// Declarative provider.ProviderWithResourceByTypeName for unit testing.
+type ProviderWithResourceByTypeName struct {
+	*Provider
+
+	// ProviderWithResourceByTypeName interface methods
+	ResourceByTypeNameMethod func(context.Context, string) func() resource.Resource
+}
+
+// ResourceByTypeName satisfies the provider.ProviderWithResourceByTypeName interface.
+func (p *ProviderWithResourceByTypeName) ResourceByTypeName(ctx context.Context, typeName string) func() resource.Resource {
+	if p.ResourceByTypeNameMethod == nil {
+		return nil
+	}
+
+	return p.ResourceByTypeNameMethod(ctx, typeName)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithValidateUnknownValues{}
+var _ provider.ProviderWithValidateUnknownValues = &ProviderWithValidateUnknownValues{}
+
+// Declarative provider.ProviderWithValidateUnknownValues for unit testing.
+type ProviderWithValidateUnknownValues struct {
+	*Provider
+
+	// ProviderWithValidateUnknownValues interface methods
+	ValidateUnknownValuesMethod func(context.Context) bool
+}
+
+// ValidateUnknownValues satisfies the provider.ProviderWithValidateUnknownValues interface.
+func (p *ProviderWithValidateUnknownValues) ValidateUnknownValues(ctx context.Context) bool {
+	if p.ValidateUnknownValuesMethod == nil {
+		return true
+	}
+
+	return p.ValidateUnknownValuesMethod(ctx)
+}
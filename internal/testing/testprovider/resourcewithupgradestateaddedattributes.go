@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithUpgradeStateAddedAttributes{}
+var _ resource.ResourceWithUpgradeStateAddedAttributes = &ResourceWithUpgradeStateAddedAttributes{}
+
+// Declarative resource.ResourceWithUpgradeStateAddedAttributes for unit testing.
+type ResourceWithUpgradeStateAddedAttributes struct {
+	*Resource
+
+	// ResourceWithUpgradeStateAddedAttributes interface methods
+	UpgradeStateAddedAttributesMethod func(context.Context) bool
+}
+
+// UpgradeStateAddedAttributes satisfies the resource.ResourceWithUpgradeStateAddedAttributes interface.
+func (p *ResourceWithUpgradeStateAddedAttributes) UpgradeStateAddedAttributes(ctx context.Context) bool {
+	if p.UpgradeStateAddedAttributesMethod == nil {
+		return false
+	}
+
+	return p.UpgradeStateAddedAttributesMethod(ctx)
+}
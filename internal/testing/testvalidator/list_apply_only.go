@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.List = &ListWithApplyOnly{}
+var _ validator.ApplyOnly = &ListWithApplyOnly{}
+
+// Declarative validator.List with validator.ApplyOnly for unit testing.
+type ListWithApplyOnly struct {
+	List
+
+	// ApplyOnly interface methods
+	ApplyOnlyMethod func(context.Context) bool
+}
+
+// ApplyOnly satisfies the validator.ApplyOnly interface.
+func (v ListWithApplyOnly) ApplyOnly(ctx context.Context) bool {
+	if v.ApplyOnlyMethod == nil {
+		return false
+	}
+
+	return v.ApplyOnlyMethod(ctx)
+}
@@ -6,6 +6,7 @@ package toproto5
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
@@ -32,7 +33,7 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 		protov5.Diagnostics = append(protov5.Diagnostics, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
 			Summary:  "Error converting provider schema",
-			Detail:   "The provider schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			Detail:   "The provider schema couldn't be converted into a usable type. This is always a problem with the provider. " + diag.ProviderDeveloperReportMessage + "\n\n" + err.Error(),
 		})
 
 		return protov5
@@ -44,7 +45,7 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 		protov5.Diagnostics = append(protov5.Diagnostics, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
 			Summary:  "Error converting provider_meta schema",
-			Detail:   "The provider_meta schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			Detail:   "The provider_meta schema couldn't be converted into a usable type. This is always a problem with the provider. " + diag.ProviderDeveloperReportMessage + "\n\n" + err.Error(),
 		})
 
 		return protov5
@@ -57,7 +58,7 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 			protov5.Diagnostics = append(protov5.Diagnostics, &tfprotov5.Diagnostic{
 				Severity: tfprotov5.DiagnosticSeverityError,
 				Summary:  "Error converting data source schema",
-				Detail:   "The schema for the data source \"" + dataSourceType + "\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+				Detail:   "The schema for the data source \"" + dataSourceType + "\" couldn't be converted into a usable type. This is always a problem with the provider. " + diag.ProviderDeveloperReportMessage + "\n\n" + err.Error(),
 			})
 
 			return protov5
@@ -71,7 +72,7 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 			protov5.Diagnostics = append(protov5.Diagnostics, &tfprotov5.Diagnostic{
 				Severity: tfprotov5.DiagnosticSeverityError,
 				Summary:  "Error converting resource schema",
-				Detail:   "The schema for the resource \"" + resourceType + "\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+				Detail:   "The schema for the resource \"" + resourceType + "\" couldn't be converted into a usable type. This is always a problem with the provider. " + diag.ProviderDeveloperReportMessage + "\n\n" + err.Error(),
 			})
 
 			return protov5
@@ -74,6 +74,18 @@ func TestDiagnostics(t *testing.T) {
 				},
 			},
 		},
+		"DiagnosticWithCode": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnosticWithCode("test_code", "one summary", "one detail"),
+			},
+			expected: []*tfprotov6.Diagnostic{
+				{
+					Detail:   "one detail",
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "one summary",
+				},
+			},
+		},
 		"Diagnostic": {
 			diags: diag.Diagnostics{
 				diag.NewErrorDiagnostic("one summary", "one detail"),
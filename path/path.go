@@ -90,21 +90,12 @@ func (p Path) Copy() Path {
 	}
 }
 
-// Equal returns true if the given path is exactly equivalent.
+// Equal returns true if the given path is exactly equivalent. Paths are
+// compared step by step, including the kind of each step, so an attribute
+// name step never equals a map key step with the same string. Paths with no
+// steps, such as the zero-value and Empty, are equal.
 func (p Path) Equal(o Path) bool {
-	if p.steps == nil && o.steps == nil {
-		return true
-	}
-
-	if p.steps == nil {
-		return false
-	}
-
-	if !p.steps.Equal(o.steps) {
-		return false
-	}
-
-	return true
+	return p.steps.Equal(o.steps)
 }
 
 // Expression returns an Expression which exactly matches the Path.
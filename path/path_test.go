@@ -246,6 +246,51 @@ func TestPathEqual(t *testing.T) {
 			other:    path.Root("test1").AtListIndex(0).AtName("test2"),
 			expected: true,
 		},
+		"equal-deep-different-construction": {
+			path:     path.Root("test1").AtMapKey("test2").AtListIndex(0).AtName("test3"),
+			other:    path.Empty().AtName("test1").AtMapKey("test2").AtName("other").ParentPath().AtListIndex(0).AtName("test3"),
+			expected: true,
+		},
+		"equal-deep-copy": {
+			path:     path.Root("test1").AtMapKey("test2").AtSetValue(types.StringValue("test3")),
+			other:    path.Root("test1").AtMapKey("test2").AtSetValue(types.StringValue("test3")).Copy(),
+			expected: true,
+		},
+		"equal-zero-value-empty": {
+			path:     path.Path{},
+			other:    path.Empty(),
+			expected: true,
+		},
+		"equal-empty-zero-value": {
+			path:     path.Empty(),
+			other:    path.Path{},
+			expected: true,
+		},
+		"different-empty-root": {
+			path:     path.Path{},
+			other:    path.Root("test"),
+			expected: false,
+		},
+		"different-attributename-mapkey-shallow": {
+			path:     path.Empty().AtName("test"),
+			other:    path.Empty().AtMapKey("test"),
+			expected: false,
+		},
+		"different-attributename-mapkey-deep": {
+			path:     path.Root("test1").AtName("test2"),
+			other:    path.Root("test1").AtMapKey("test2"),
+			expected: false,
+		},
+		"different-mapkey-attributename-deep": {
+			path:     path.Root("test1").AtMapKey("test2").AtName("test3"),
+			other:    path.Root("test1").AtName("test2").AtName("test3"),
+			expected: false,
+		},
+		"different-listindex-setvalue": {
+			path:     path.Root("test").AtListIndex(0),
+			other:    path.Root("test").AtSetValue(types.Int64Value(0)),
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
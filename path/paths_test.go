@@ -80,6 +80,29 @@ func TestPathsAppend(t *testing.T) {
 				path.Root("test3"),
 			},
 		},
+		"deduplication-different-construction": {
+			paths: path.Paths{
+				path.Root("test1").AtListIndex(0).AtName("test2"),
+			},
+			add: path.Paths{
+				path.Empty().AtName("test1").AtListIndex(0).AtName("test2"),
+			},
+			expected: path.Paths{
+				path.Root("test1").AtListIndex(0).AtName("test2"),
+			},
+		},
+		"no-deduplication-attributename-mapkey": {
+			paths: path.Paths{
+				path.Root("test1").AtName("test2"),
+			},
+			add: path.Paths{
+				path.Root("test1").AtMapKey("test2"),
+			},
+			expected: path.Paths{
+				path.Root("test1").AtName("test2"),
+				path.Root("test1").AtMapKey("test2"),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// PathForStructField returns the Path of the Go struct field named
+// goFieldName within structVal, appending an AtName step for each field
+// using its tfsdk struct tag. This is intended for tooling, such as code
+// generated providers, which reports diagnostics against the attributes that
+// correspond to struct fields.
+//
+// Fields of nested structs are referenced by joining Go field names with
+// periods, such as "Parent.Child", which produces AtName steps for both the
+// parent and child tfsdk tags. The structVal may be a struct or a pointer to
+// a struct and nested fields may be pointers to structs.
+//
+// An error is returned if a field does not exist, is unexported, or does not
+// have a tfsdk struct tag.
+func PathForStructField(root Path, structVal interface{}, goFieldName string) (Path, error) {
+	if goFieldName == "" {
+		return root, fmt.Errorf("struct field name must not be empty")
+	}
+
+	typ := reflect.TypeOf(structVal)
+	result := root
+
+	for _, fieldName := range strings.Split(goFieldName, ".") {
+		for typ != nil && typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+
+		if typ == nil || typ.Kind() != reflect.Struct {
+			return root, fmt.Errorf("cannot find field %q of %s, is not a struct", fieldName, typ)
+		}
+
+		field, ok := typ.FieldByName(fieldName)
+
+		if !ok {
+			return root, fmt.Errorf("%s does not have a field named %q", typ, fieldName)
+		}
+
+		if field.PkgPath != "" {
+			return root, fmt.Errorf("%s field %q is unexported", typ, fieldName)
+		}
+
+		tag := field.Tag.Get("tfsdk")
+
+		if tag == "" || tag == "-" {
+			return root, fmt.Errorf("%s field %q does not have a tfsdk struct tag", typ, fieldName)
+		}
+
+		result = result.AtName(tag)
+		typ = field.Type
+	}
+
+	return result, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPathForStructField(t *testing.T) {
+	t.Parallel()
+
+	type testNestedModel struct {
+		Name types.String `tfsdk:"name"`
+	}
+
+	type testModel struct {
+		ID         types.String     `tfsdk:"id"`
+		Nested     testNestedModel  `tfsdk:"nested"`
+		NestedPtr  *testNestedModel `tfsdk:"nested_ptr"`
+		Ignored    types.String     `tfsdk:"-"`
+		unexported types.String     //nolint:unused // Testing unexported field handling
+	}
+
+	testCases := map[string]struct {
+		root          path.Path
+		structVal     interface{}
+		goFieldName   string
+		expected      path.Path
+		expectedError string
+	}{
+		"top-level": {
+			root:        path.Empty(),
+			structVal:   testModel{},
+			goFieldName: "ID",
+			expected:    path.Root("id"),
+		},
+		"top-level-pointer": {
+			root:        path.Empty(),
+			structVal:   &testModel{},
+			goFieldName: "ID",
+			expected:    path.Root("id"),
+		},
+		"nested": {
+			root:        path.Empty(),
+			structVal:   testModel{},
+			goFieldName: "Nested.Name",
+			expected:    path.Root("nested").AtName("name"),
+		},
+		"nested-pointer": {
+			root:        path.Empty(),
+			structVal:   testModel{},
+			goFieldName: "NestedPtr.Name",
+			expected:    path.Root("nested_ptr").AtName("name"),
+		},
+		"root": {
+			root:        path.Root("parent").AtListIndex(0),
+			structVal:   testModel{},
+			goFieldName: "Nested.Name",
+			expected:    path.Root("parent").AtListIndex(0).AtName("nested").AtName("name"),
+		},
+		"empty-field-name": {
+			root:          path.Empty(),
+			structVal:     testModel{},
+			goFieldName:   "",
+			expected:      path.Empty(),
+			expectedError: "struct field name must not be empty",
+		},
+		"missing-field": {
+			root:          path.Empty(),
+			structVal:     testModel{},
+			goFieldName:   "Missing",
+			expected:      path.Empty(),
+			expectedError: `path_test.testModel does not have a field named "Missing"`,
+		},
+		"ignored-field": {
+			root:          path.Empty(),
+			structVal:     testModel{},
+			goFieldName:   "Ignored",
+			expected:      path.Empty(),
+			expectedError: `path_test.testModel field "Ignored" does not have a tfsdk struct tag`,
+		},
+		"unexported-field": {
+			root:          path.Empty(),
+			structVal:     testModel{},
+			goFieldName:   "unexported",
+			expected:      path.Empty(),
+			expectedError: `path_test.testModel field "unexported" is unexported`,
+		},
+		"not-struct": {
+			root:          path.Empty(),
+			structVal:     "test",
+			goFieldName:   "ID",
+			expected:      path.Empty(),
+			expectedError: `cannot find field "ID" of string, is not a struct`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.PathForStructField(testCase.root, testCase.structVal, testCase.goFieldName)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Errorf("unexpected error difference: %s", diff)
+				}
+			} else if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
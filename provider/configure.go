@@ -16,7 +16,8 @@ type ConfigureRequest struct {
 	// TerraformVersion is the version of Terraform executing the request.
 	// This is supplied for logging, analytics, and User-Agent purposes
 	// only. Providers should not try to gate provider behavior on
-	// Terraform versions.
+	// Terraform versions. To require a minimum Terraform version, implement
+	// the ProviderWithMinimumTerraformVersion interface instead.
 	TerraformVersion string
 
 	// Config is the configuration the user supplied for the provider. This
@@ -824,7 +824,7 @@ func TestSchemaValidate(t *testing.T) {
 		"validate-implementation-error": {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
-					"^": metaschema.StringAttribute{},
+					"^": metaschema.StringAttribute{Optional: true},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -867,7 +867,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"attribute-using-invalid-field-name": {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
-					"^": metaschema.StringAttribute{},
+					"^": metaschema.StringAttribute{Optional: true},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -884,8 +884,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
 					"single_nested_attribute": metaschema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]metaschema.Attribute{
-							"^": metaschema.BoolAttribute{},
+							"^": metaschema.BoolAttribute{Optional: true},
 						},
 					},
 				},
@@ -922,6 +923,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
 					"list_nested_attribute": metaschema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: metaschema.NestedAttributeObject{
 							Attributes: map[string]metaschema.Attribute{
 								"test": metaschema.ListAttribute{
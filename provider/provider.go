@@ -17,6 +17,8 @@ import (
 //   - Validation: Schema-based or entire configuration
 //     via ProviderWithConfigValidators or ProviderWithValidateConfig.
 //   - Meta Schema: ProviderWithMetaSchema
+//   - Type Name Lookup: ProviderWithDataSourceByTypeName or
+//     ProviderWithResourceByTypeName
 type Provider interface {
 	// Metadata should return the metadata for the provider, such as
 	// a type name and version data.
@@ -68,6 +70,25 @@ type ProviderWithConfigValidators interface {
 	ConfigValidators(context.Context) []ConfigValidator
 }
 
+// ProviderWithDataSourceByTypeName is an interface type that extends Provider
+// to look up a single data source by its type name. When implemented, the
+// framework calls this method for RPCs which operate on a single data source,
+// such as ReadDataSource, rather than instantiating every data source returned
+// by the DataSources method to determine its type name. This can reduce the
+// per-RPC cost for providers with a large number of data sources.
+//
+// The DataSources method is still called for RPCs which operate on all data
+// sources, such as GetProviderSchema, so both methods must return consistent
+// results.
+type ProviderWithDataSourceByTypeName interface {
+	Provider
+
+	// DataSourceByTypeName should return the function to instantiate the
+	// DataSource implementation whose Metadata method returns the given
+	// type name, or nil if the provider has no such data source.
+	DataSourceByTypeName(ctx context.Context, typeName string) func() datasource.DataSource
+}
+
 // ProviderWithMetaSchema is a provider with a provider meta schema, which
 // is configured by practitioners via the provider_meta configuration block
 // and the configuration data is included with certain data source and resource
@@ -89,6 +110,39 @@ type ProviderWithMetaSchema interface {
 	MetaSchema(context.Context, MetaSchemaRequest, *MetaSchemaResponse)
 }
 
+// ProviderWithMinimumTerraformVersion is an interface type that extends
+// Provider to declare the minimum Terraform version supported by the provider.
+// When Terraform advertises an earlier version during provider configuration,
+// the framework returns an error diagnostic instead of calling the provider
+// Configure method.
+type ProviderWithMinimumTerraformVersion interface {
+	Provider
+
+	// MinimumTerraformVersion should return the minimum supported Terraform
+	// version, such as "1.3.0". Any pre-release or build metadata suffix is
+	// ignored when comparing versions.
+	MinimumTerraformVersion(context.Context) string
+}
+
+// ProviderWithResourceByTypeName is an interface type that extends Provider
+// to look up a single resource by its type name. When implemented, the
+// framework calls this method for RPCs which operate on a single resource,
+// such as ReadResource, rather than instantiating every resource returned by
+// the Resources method to determine its type name. This can reduce the
+// per-RPC cost for providers with a large number of resources.
+//
+// The Resources method is still called for RPCs which operate on all
+// resources, such as GetProviderSchema, so both methods must return
+// consistent results.
+type ProviderWithResourceByTypeName interface {
+	Provider
+
+	// ResourceByTypeName should return the function to instantiate the
+	// Resource implementation whose Metadata method returns the given type
+	// name, or nil if the provider has no such resource.
+	ResourceByTypeName(ctx context.Context, typeName string) func() resource.Resource
+}
+
 // ProviderWithValidateConfig is an interface type that extends Provider to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
@@ -103,3 +157,19 @@ type ProviderWithValidateConfig interface {
 	// ValidateConfig performs the validation.
 	ValidateConfig(context.Context, ValidateConfigRequest, *ValidateConfigResponse)
 }
+
+// ProviderWithValidateUnknownValues is an interface type that extends Provider
+// to control whether attribute and block validators are called with unknown
+// configuration values across all data sources, resources, and the provider
+// itself. By default, validators are called with unknown values, which they
+// can detect via the IsUnknown method of the request ConfigValue.
+//
+// Validation of unknown values is still performed during a later plan or
+// apply, once the values become known.
+type ProviderWithValidateUnknownValues interface {
+	Provider
+
+	// ValidateUnknownValues should return false to prevent attribute and
+	// block validators from being called with unknown configuration values.
+	ValidateUnknownValues(context.Context) bool
+}
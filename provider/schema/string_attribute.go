@@ -15,8 +15,9 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                               = StringAttribute{}
-	_ fwxschema.AttributeWithStringValidators = StringAttribute{}
+	_ Attribute                                 = StringAttribute{}
+	_ fwschema.AttributeWithEnvironmentVariable = StringAttribute{}
+	_ fwxschema.AttributeWithStringValidators   = StringAttribute{}
 )
 
 // StringAttribute represents a schema attribute that is a string. When
@@ -101,6 +102,23 @@ type StringAttribute struct {
 	//
 	DeprecationMessage string
 
+	// EnvironmentVariable is the name of an environment variable which, when
+	// set to a non-empty value, overrides the configuration value of this
+	// attribute in the provider Configure method request. This is intended
+	// for overriding configuration in environments such as continuous
+	// integration without changing Terraform configuration files.
+	//
+	// The precedence, from highest to lowest, is:
+	//
+	//  - The environment variable value, if set and non-empty.
+	//  - The configuration value, which may be null.
+	//
+	// Only top level attributes support this field. As Terraform validates
+	// configuration before the override is applied, attributes using this
+	// field should be Optional rather than Required, and validators only
+	// receive the configuration value.
+	EnvironmentVariable string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -140,6 +158,11 @@ func (a StringAttribute) GetDescription() string {
 	return a.Description
 }
 
+// GetEnvironmentVariable returns the EnvironmentVariable field value.
+func (a StringAttribute) GetEnvironmentVariable() string {
+	return a.EnvironmentVariable
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (a StringAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -186,6 +186,40 @@ func TestStringAttributeGetDescription(t *testing.T) {
 	}
 }
 
+func TestStringAttributeGetEnvironmentVariable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.StringAttribute
+		expected  string
+	}{
+		"no-environment-variable": {
+			attribute: schema.StringAttribute{},
+			expected:  "",
+		},
+		"environment-variable": {
+			attribute: schema.StringAttribute{
+				EnvironmentVariable: "TEST_VARIABLE",
+			},
+			expected: "TEST_VARIABLE",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetEnvironmentVariable()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestStringAttributeGetMarkdownDescription(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AddressString returns a consistent identifier for a resource instance in
+// the form type.id, suitable for log lines and diagnostics. Null and unknown
+// identifiers are rendered as <null> and <unknown> respectively, so the
+// identifier always contains the resource type name.
+func AddressString(typeName string, id types.String) string {
+	if id.IsNull() || id.IsUnknown() {
+		return typeName + "." + id.String()
+	}
+
+	return typeName + "." + id.ValueString()
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAddressString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeName string
+		id       types.String
+		expected string
+	}{
+		"known": {
+			typeName: "examplecloud_thing",
+			id:       types.StringValue("abc-123"),
+			expected: "examplecloud_thing.abc-123",
+		},
+		"known-empty": {
+			typeName: "examplecloud_thing",
+			id:       types.StringValue(""),
+			expected: "examplecloud_thing.",
+		},
+		"null": {
+			typeName: "examplecloud_thing",
+			id:       types.StringNull(),
+			expected: "examplecloud_thing.<null>",
+		},
+		"unknown": {
+			typeName: "examplecloud_thing",
+			id:       types.StringUnknown(),
+			expected: "examplecloud_thing.<unknown>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := resource.AddressString(testCase.typeName, testCase.id)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
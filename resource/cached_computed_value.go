@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
+// cachedComputedValueData is the private state data saved by
+// CachedComputedValue.
+type cachedComputedValueData struct {
+	// Inputs contains the MessagePack encoding of each input value.
+	Inputs [][]byte `json:"inputs"`
+
+	// Value contains the MessagePack encoding of the computed value.
+	Value []byte `json:"value"`
+}
+
+// CachedComputedValue is a helper function for expensive computed attribute
+// values, which caches the computed value in the resource private state at
+// the given key. If the private state contains a value which was computed
+// with inputs equal to the given inputs, the cached value is returned without
+// calling compute. Otherwise, compute is called and its result is saved with
+// the inputs for later operations.
+//
+// The private state should be the response Private field of the resource
+// operation, such as CreateResponse or ReadResponse. The value type must be
+// the type of the computed value. Values are only cached when all inputs and
+// the computed value are fully known, and are never cached when private is
+// nil. The inputs are saved alongside the value, so inputs should be kept
+// reasonably small.
+func CachedComputedValue(ctx context.Context, private *privatestate.ProviderData, key string, valueType attr.Type, inputs []attr.Value, compute func(context.Context) (attr.Value, diag.Diagnostics)) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfInputs := make([]tftypes.Value, 0, len(inputs))
+	cacheable := private != nil
+
+	for _, input := range inputs {
+		tfInput, err := input.ToTerraformValue(ctx)
+
+		if err != nil {
+			diags.AddError(
+				"Unable to Convert Cached Computed Value Input",
+				"An unexpected error was encountered when converting a cached computed value input. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return nil, diags
+		}
+
+		if !tfInput.IsFullyKnown() {
+			cacheable = false
+		}
+
+		tfInputs = append(tfInputs, tfInput)
+	}
+
+	if cacheable {
+		cachedValue, cachedDiags := getCachedComputedValue(ctx, private, key, valueType, tfInputs)
+
+		diags.Append(cachedDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if cachedValue != nil {
+			logging.FrameworkTrace(ctx, "Using cached computed value from private state", map[string]any{
+				"key": key,
+			})
+
+			return cachedValue, diags
+		}
+	}
+
+	value, computeDiags := compute(ctx)
+
+	diags.Append(computeDiags...)
+
+	if diags.HasError() || value == nil || !cacheable {
+		return value, diags
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Convert Cached Computed Value",
+			"An unexpected error was encountered when converting a computed value for caching. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return value, diags
+	}
+
+	if !tfValue.IsFullyKnown() {
+		return value, diags
+	}
+
+	data := cachedComputedValueData{
+		Inputs: make([][]byte, 0, len(tfInputs)),
+	}
+
+	for _, tfInput := range tfInputs {
+		dynamicValue, err := tfprotov6.NewDynamicValue(tfInput.Type(), tfInput)
+
+		if err != nil {
+			diags.Append(cachedComputedValueEncodeErrorDiag(err))
+
+			return value, diags
+		}
+
+		data.Inputs = append(data.Inputs, dynamicValue.MsgPack)
+	}
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(tfValue.Type(), tfValue)
+
+	if err != nil {
+		diags.Append(cachedComputedValueEncodeErrorDiag(err))
+
+		return value, diags
+	}
+
+	data.Value = dynamicValue.MsgPack
+
+	dataBytes, err := json.Marshal(data)
+
+	if err != nil {
+		diags.Append(cachedComputedValueEncodeErrorDiag(err))
+
+		return value, diags
+	}
+
+	diags.Append(private.SetKey(ctx, key, dataBytes)...)
+
+	return value, diags
+}
+
+// getCachedComputedValue returns the cached value from private state if the
+// cached inputs are equal to the given inputs, otherwise nil.
+func getCachedComputedValue(ctx context.Context, private *privatestate.ProviderData, key string, valueType attr.Type, tfInputs []tftypes.Value) (attr.Value, diag.Diagnostics) {
+	dataBytes, diags := private.GetKey(ctx, key)
+
+	if diags.HasError() || dataBytes == nil {
+		return nil, diags
+	}
+
+	var data cachedComputedValueData
+
+	// Treat any previously saved data which cannot be decoded as a cache
+	// miss, so the value is recomputed and the data overwritten.
+	if err := json.Unmarshal(dataBytes, &data); err != nil {
+		return nil, diags
+	}
+
+	if len(data.Inputs) != len(tfInputs) {
+		return nil, diags
+	}
+
+	for index, tfInput := range tfInputs {
+		cachedInput, err := (&tfprotov6.DynamicValue{MsgPack: data.Inputs[index]}).Unmarshal(tfInput.Type())
+
+		if err != nil || !cachedInput.Equal(tfInput) {
+			return nil, diags
+		}
+	}
+
+	tfValue, err := (&tfprotov6.DynamicValue{MsgPack: data.Value}).Unmarshal(valueType.TerraformType(ctx))
+
+	if err != nil {
+		return nil, diags
+	}
+
+	value, err := valueType.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		return nil, diags
+	}
+
+	return value, diags
+}
+
+// cachedComputedValueEncodeErrorDiag returns an error diagnostic for when
+// CachedComputedValue cannot encode the private state data.
+func cachedComputedValueEncodeErrorDiag(err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Unable to Encode Cached Computed Value",
+		"An unexpected error was encountered when encoding a computed value for caching. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			"Error: "+err.Error(),
+	)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCachedComputedValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	private := privatestate.EmptyProviderData(ctx)
+
+	var computeCalls int
+
+	compute := func(input string) func(context.Context) (attr.Value, diag.Diagnostics) {
+		return func(context.Context) (attr.Value, diag.Diagnostics) {
+			computeCalls++
+
+			return types.StringValue("computed-" + input), nil
+		}
+	}
+
+	testSteps := []struct {
+		input         attr.Value
+		expected      attr.Value
+		expectedCalls int
+	}{
+		// Initial computation.
+		{
+			input:         types.StringValue("one"),
+			expected:      types.StringValue("computed-one"),
+			expectedCalls: 1,
+		},
+		// Unchanged input reuses the cached value.
+		{
+			input:         types.StringValue("one"),
+			expected:      types.StringValue("computed-one"),
+			expectedCalls: 1,
+		},
+		// Changed input recomputes the value.
+		{
+			input:         types.StringValue("two"),
+			expected:      types.StringValue("computed-two"),
+			expectedCalls: 2,
+		},
+		// Unchanged input reuses the recomputed value.
+		{
+			input:         types.StringValue("two"),
+			expected:      types.StringValue("computed-two"),
+			expectedCalls: 2,
+		},
+		// Unknown input always computes without caching.
+		{
+			input:         types.StringUnknown(),
+			expected:      types.StringValue("computed-unknown"),
+			expectedCalls: 3,
+		},
+		// Cached value is still available after an unknown input.
+		{
+			input:         types.StringValue("two"),
+			expected:      types.StringValue("computed-two"),
+			expectedCalls: 3,
+		},
+	}
+
+	for stepNumber, step := range testSteps {
+		input := "unknown"
+
+		if !step.input.IsUnknown() {
+			input = step.input.(types.String).ValueString()
+		}
+
+		got, diags := resource.CachedComputedValue(ctx, private, "test_key", types.StringType, []attr.Value{types.StringValue("static"), step.input}, compute(input))
+
+		if diags.HasError() {
+			t.Fatalf("step %d: unexpected diagnostics: %v", stepNumber, diags)
+		}
+
+		if !got.Equal(step.expected) {
+			t.Errorf("step %d: expected value %s, got: %s", stepNumber, step.expected, got)
+		}
+
+		if computeCalls != step.expectedCalls {
+			t.Errorf("step %d: expected %d compute calls, got: %d", stepNumber, step.expectedCalls, computeCalls)
+		}
+	}
+}
+
+func TestCachedComputedValue_nilPrivate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var computeCalls int
+
+	compute := func(context.Context) (attr.Value, diag.Diagnostics) {
+		computeCalls++
+
+		return types.StringValue("computed"), nil
+	}
+
+	for i := 0; i < 2; i++ {
+		got, diags := resource.CachedComputedValue(ctx, nil, "test_key", types.StringType, []attr.Value{types.StringValue("input")}, compute)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if !got.Equal(types.StringValue("computed")) {
+			t.Errorf("unexpected value: %s", got)
+		}
+	}
+
+	if computeCalls != 2 {
+		t.Errorf("expected 2 compute calls, got: %d", computeCalls)
+	}
+}
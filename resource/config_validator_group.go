@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"strings"
+)
+
+var _ ConfigValidator = ConfigValidatorGroup{}
+
+// ConfigValidatorGroup is a reusable bundle of ConfigValidator, such as a
+// standard set of validators shared across many resources. The group itself
+// implements ConfigValidator, so groups can be nested within other groups or
+// returned alongside other validators from the ResourceWithConfigValidators
+// interface ConfigValidators method without concatenating slices:
+//
+//	var standardValidators = resource.ConfigValidatorGroup{
+//		validatorOne,
+//		validatorTwo,
+//	}
+//
+//	func (r ExampleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+//		return []resource.ConfigValidator{
+//			standardValidators,
+//			resourceSpecificValidator,
+//		}
+//	}
+//
+// All contained validators are called in order and their diagnostics are
+// aggregated, including when a prior validator returned an error.
+type ConfigValidatorGroup []ConfigValidator
+
+// Description returns the plain text descriptions of all contained
+// validators.
+func (g ConfigValidatorGroup) Description(ctx context.Context) string {
+	descriptions := make([]string, 0, len(g))
+
+	for _, validator := range g {
+		if validator == nil {
+			continue
+		}
+
+		if description := validator.Description(ctx); description != "" {
+			descriptions = append(descriptions, description)
+		}
+	}
+
+	return strings.Join(descriptions, " and ")
+}
+
+// MarkdownDescription returns the Markdown descriptions of all contained
+// validators.
+func (g ConfigValidatorGroup) MarkdownDescription(ctx context.Context) string {
+	descriptions := make([]string, 0, len(g))
+
+	for _, validator := range g {
+		if validator == nil {
+			continue
+		}
+
+		if description := validator.MarkdownDescription(ctx); description != "" {
+			descriptions = append(descriptions, description)
+		}
+	}
+
+	return strings.Join(descriptions, " and ")
+}
+
+// ValidateResource calls all contained validators and aggregates their
+// diagnostics into the response.
+func (g ConfigValidatorGroup) ValidateResource(ctx context.Context, req ValidateConfigRequest, resp *ValidateConfigResponse) {
+	for _, validator := range g {
+		if validator == nil {
+			continue
+		}
+
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &ValidateConfigResponse{}
+
+		validator.ValidateResource(ctx, req, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestConfigValidatorGroupValidateResource(t *testing.T) {
+	t.Parallel()
+
+	testValidator := func(summary string) resource.ConfigValidator {
+		return &testprovider.ResourceConfigValidator{
+			DescriptionMethod: func(_ context.Context) string {
+				return summary + " description"
+			},
+			ValidateResourceMethod: func(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+				resp.Diagnostics.AddError(summary, "detail")
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		group               resource.ConfigValidatorGroup
+		expectedDescription string
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"empty": {
+			group: resource.ConfigValidatorGroup{},
+		},
+		"flat": {
+			group: resource.ConfigValidatorGroup{
+				testValidator("one"),
+				testValidator("two"),
+			},
+			expectedDescription: "one description and two description",
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewErrorDiagnostic("two", "detail"),
+			},
+		},
+		"nested": {
+			group: resource.ConfigValidatorGroup{
+				testValidator("one"),
+				resource.ConfigValidatorGroup{
+					testValidator("two"),
+					resource.ConfigValidatorGroup{
+						testValidator("three"),
+					},
+				},
+				nil,
+				testValidator("four"),
+			},
+			expectedDescription: "one description and two description and three description and four description",
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewErrorDiagnostic("two", "detail"),
+				diag.NewErrorDiagnostic("three", "detail"),
+				diag.NewErrorDiagnostic("four", "detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &resource.ValidateConfigResponse{}
+
+			testCase.group.ValidateResource(context.Background(), resource.ValidateConfigRequest{}, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.group.Description(context.Background()), testCase.expectedDescription); diff != "" {
+				t.Errorf("unexpected description difference: %s", diff)
+			}
+		})
+	}
+}
@@ -4,8 +4,18 @@
 package resource
 
 import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -35,6 +45,11 @@ type CreateResponse struct {
 	// State is the state of the resource following the Create operation.
 	// This field is pre-populated from CreateRequest.Plan and
 	// should be set during the resource's Create operation.
+	//
+	// For long-running operations, values can be set as they become known,
+	// such as with State.SetAttribute. Any state set before returning an
+	// error diagnostic is still saved by Terraform, which marks the resource
+	// as tainted for recreation on the next plan.
 	State tfsdk.State
 
 	// Private is the private state resource data following the Create operation.
@@ -47,3 +62,122 @@ type CreateResponse struct {
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
 }
+
+// CreateCompositeID is a helper function to set a synthetic identifier, which
+// is composed of other state attribute values joined by the separator, to a
+// given state attribute path. The identifier attribute must accept a string
+// value. Component attributes must be string, number, or bool values which
+// are neither null nor unknown. Component values are read from the response
+// State, so any component values returned by the remote system should be set
+// before calling this function.
+//
+//	resource.CreateCompositeID(ctx, path.Root("id"), ",", []path.Path{path.Root("region"), path.Root("name")}, resp)
+func CreateCompositeID(ctx context.Context, idPath path.Path, separator string, componentPaths []path.Path, resp *CreateResponse) {
+	if idPath.Equal(path.Empty()) {
+		resp.Diagnostics.AddError(
+			"Resource Composite ID Missing Attribute Path",
+			"This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				"Resource Create method call to CreateCompositeID path must be set to a valid attribute path that can accept a string value.",
+		)
+
+		return
+	}
+
+	if len(componentPaths) == 0 {
+		resp.Diagnostics.AddError(
+			"Resource Composite ID Missing Component Paths",
+			"This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				"Resource Create method call to CreateCompositeID component paths must contain at least one valid attribute path.",
+		)
+
+		return
+	}
+
+	// Only diagnostics from this call determine whether the identifier is
+	// set, as the response may already contain unrelated errors.
+	var diags diag.Diagnostics
+
+	components := make([]string, 0, len(componentPaths))
+
+	for _, componentPath := range componentPaths {
+		var componentValue attr.Value
+
+		getDiags := resp.State.GetAttribute(ctx, componentPath, &componentValue)
+
+		diags.Append(getDiags...)
+
+		if getDiags.HasError() {
+			continue
+		}
+
+		component, err := compositeIDComponent(ctx, componentValue)
+
+		if err != nil {
+			diags.AddAttributeError(
+				componentPath,
+				"Invalid Resource Composite ID Component",
+				"This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+					"Resource Create method call to CreateCompositeID could not use the component attribute value: "+err.Error(),
+			)
+
+			continue
+		}
+
+		components = append(components, component)
+	}
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, idPath, strings.Join(components, separator))...)
+}
+
+// compositeIDComponent returns the string representation of a known
+// primitive value for use in CreateCompositeID.
+func compositeIDComponent(ctx context.Context, value attr.Value) (string, error) {
+	if value.IsNull() {
+		return "", fmt.Errorf("value must not be null")
+	}
+
+	if value.IsUnknown() {
+		return "", fmt.Errorf("value must not be unknown")
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case tfValue.Type().Is(tftypes.String):
+		var result string
+
+		if err := tfValue.As(&result); err != nil {
+			return "", err
+		}
+
+		return result, nil
+	case tfValue.Type().Is(tftypes.Number):
+		result := new(big.Float)
+
+		if err := tfValue.As(&result); err != nil {
+			return "", err
+		}
+
+		return result.Text('f', -1), nil
+	case tfValue.Type().Is(tftypes.Bool):
+		var result bool
+
+		if err := tfValue.As(&result); err != nil {
+			return "", err
+		}
+
+		return strconv.FormatBool(result), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %s, must be a string, number, or bool", tfValue.Type())
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCreateCompositeID(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"number": schema.Int64Attribute{
+				Computed: true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"id":      tftypes.String,
+			"name":    tftypes.String,
+			"number":  tftypes.Number,
+			"tags":    tftypes.List{ElementType: tftypes.String},
+		},
+	}
+	testState := func(id tftypes.Value, number tftypes.Value) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"id":      id,
+				"name":    tftypes.NewValue(tftypes.String, "test-name"),
+				"number":  number,
+				"tags": tftypes.NewValue(
+					tftypes.List{ElementType: tftypes.String},
+					[]tftypes.Value{tftypes.NewValue(tftypes.String, "test-tag")},
+				),
+			}),
+		}
+	}
+
+	testCases := map[string]struct {
+		idPath         path.Path
+		separator      string
+		componentPaths []path.Path
+		diagnostics    diag.Diagnostics
+		state          tfsdk.State
+		expected       tfsdk.State
+		expectedDiags  diag.Diagnostics
+	}{
+		"single-component": {
+			idPath:         path.Root("id"),
+			separator:      ",",
+			componentPaths: []path.Path{path.Root("name")},
+			state:          testState(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.Number, 123)),
+			expected:       testState(tftypes.NewValue(tftypes.String, "test-name"), tftypes.NewValue(tftypes.Number, 123)),
+		},
+		"multiple-components": {
+			idPath:         path.Root("id"),
+			separator:      "/",
+			componentPaths: []path.Path{path.Root("name"), path.Root("number"), path.Root("enabled")},
+			state:          testState(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.Number, 123)),
+			expected:       testState(tftypes.NewValue(tftypes.String, "test-name/123/true"), tftypes.NewValue(tftypes.Number, 123)),
+		},
+		"existing-error-diagnostic": {
+			idPath:         path.Root("id"),
+			separator:      ",",
+			componentPaths: []path.Path{path.Root("name")},
+			diagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic("existing error summary", "existing error detail"),
+			},
+			state:    testState(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.Number, 123)),
+			expected: testState(tftypes.NewValue(tftypes.String, "test-name"), tftypes.NewValue(tftypes.Number, 123)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("existing error summary", "existing error detail"),
+			},
+		},
+		"component-unknown": {
+			idPath:         path.Root("id"),
+			separator:      ",",
+			componentPaths: []path.Path{path.Root("name"), path.Root("number")},
+			state:          testState(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)),
+			expected:       testState(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("number"),
+					"Invalid Resource Composite ID Component",
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Resource Create method call to CreateCompositeID could not use the component attribute value: value must not be unknown",
+				),
+			},
+		},
+		"component-unsupported-type": {
+			idPath:         path.Root("id"),
+			separator:      ",",
+			componentPaths: []path.Path{path.Root("tags")},
+			state:          testState(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.Number, 123)),
+			expected:       testState(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.Number, 123)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("tags"),
+					"Invalid Resource Composite ID Component",
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Resource Create method call to CreateCompositeID could not use the component attribute value: unsupported value type tftypes.List[tftypes.String], must be a string, number, or bool",
+				),
+			},
+		},
+		"component-paths-missing": {
+			idPath:    path.Root("id"),
+			separator: ",",
+			state:     testState(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.Number, 123)),
+			expected:  testState(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.Number, 123)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Composite ID Missing Component Paths",
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Resource Create method call to CreateCompositeID component paths must contain at least one valid attribute path.",
+				),
+			},
+		},
+		"id-path-missing": {
+			idPath:         path.Empty(),
+			separator:      ",",
+			componentPaths: []path.Path{path.Root("name")},
+			state:          testState(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.Number, 123)),
+			expected:       testState(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.Number, 123)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Composite ID Missing Attribute Path",
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Resource Create method call to CreateCompositeID path must be set to a valid attribute path that can accept a string value.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &resource.CreateResponse{
+				Diagnostics: testCase.diagnostics,
+				State:       testCase.state,
+			}
+
+			resource.CreateCompositeID(context.Background(), testCase.idPath, testCase.separator, testCase.componentPaths, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.State, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
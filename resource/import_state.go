@@ -44,6 +44,14 @@ type ImportStateResponse struct {
 	// This field is not pre-populated as there is no pre-existing private state
 	// data during the resource's Import operation.
 	Private *privatestate.ProviderData
+
+	// ReadAfterImport, when true, signals the framework to call the Resource
+	// Read method with the imported State and Private data before responding
+	// to Terraform. The imported resource then includes any remote data set
+	// by the Read method, such as Computed attributes, within the same import
+	// operation. The imported State must contain enough information for the
+	// Read method to find the remote object.
+	ReadAfterImport bool
 }
 
 // ImportStatePassthroughID is a helper function to set the import
@@ -53,7 +61,7 @@ func ImportStatePassthroughID(ctx context.Context, attrPath path.Path, req Impor
 	if attrPath.Equal(path.Empty()) {
 		resp.Diagnostics.AddError(
 			"Resource Import Passthrough Missing Attribute Path",
-			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				"Resource ImportState method call to ImportStatePassthroughID path must be set to a valid attribute path that can accept a string value.",
 		)
 	}
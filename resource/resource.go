@@ -18,7 +18,8 @@ import (
 //     via ResourceWithConfigValidators or ResourceWithValidateConfig.
 //   - Plan Modification: Schema-based or entire plan
 //     via ResourceWithModifyPlan.
-//   - State Upgrades: ResourceWithUpgradeState
+//   - State Upgrades: ResourceWithUpgradeState or
+//     ResourceWithUpgradeStateAddedAttributes
 //
 // Although not required, it is conventional for resources to implement the
 // ResourceWithImportState interface.
@@ -153,6 +154,21 @@ type ResourceWithUpgradeState interface {
 	UpgradeState(context.Context) map[int64]StateUpgrader
 }
 
+// ResourceWithUpgradeStateAddedAttributes is an interface type that extends
+// Resource to opt into framework handling of the UpgradeResourceState RPC when
+// the only schema changes since the prior state version are added attributes
+// which are not Required. The added attributes are set to null in the
+// upgraded state. This is only used when the resource does not implement
+// ResourceWithUpgradeState, so provider defined state upgrade logic is never
+// bypassed.
+type ResourceWithUpgradeStateAddedAttributes interface {
+	Resource
+
+	// UpgradeStateAddedAttributes should return true to enable framework
+	// handling of state upgrades for added attributes.
+	UpgradeStateAddedAttributes(context.Context) bool
+}
+
 // ResourceWithValidateConfig is an interface type that extends Resource to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
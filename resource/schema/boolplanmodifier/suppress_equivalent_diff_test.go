@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boolplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressEquivalentDiffModifierPlanModifyBool(t *testing.T) {
+	t.Parallel()
+
+	testSchema := func(computed bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"testattr": schema.BoolAttribute{
+					Optional: true,
+					Computed: computed,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		request     planmodifier.BoolRequest
+		equivalent  bool
+		notComputed bool
+		expected    *planmodifier.BoolResponse
+	}{
+		"null-state": {
+			// no prior state, such as on resource creation
+			request: planmodifier.BoolRequest{
+				StateValue:  types.BoolNull(),
+				PlanValue:   types.BoolValue(false),
+				ConfigValue: types.BoolValue(false),
+			},
+			equivalent: true,
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(false),
+			},
+		},
+		"null-config": {
+			request: planmodifier.BoolRequest{
+				StateValue:  types.BoolValue(true),
+				PlanValue:   types.BoolUnknown(),
+				ConfigValue: types.BoolNull(),
+			},
+			equivalent: true,
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.BoolRequest{
+				StateValue:  types.BoolValue(true),
+				PlanValue:   types.BoolUnknown(),
+				ConfigValue: types.BoolUnknown(),
+			},
+			equivalent: true,
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+		"equivalent": {
+			// the diff is suppressed by keeping the prior state value
+			request: planmodifier.BoolRequest{
+				StateValue:  types.BoolValue(true),
+				PlanValue:   types.BoolValue(false),
+				ConfigValue: types.BoolValue(false),
+			},
+			equivalent: true,
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"not-equivalent": {
+			request: planmodifier.BoolRequest{
+				StateValue:  types.BoolValue(true),
+				PlanValue:   types.BoolValue(false),
+				ConfigValue: types.BoolValue(false),
+			},
+			equivalent: false,
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(false),
+			},
+		},
+		"not-computed": {
+			request: planmodifier.BoolRequest{
+				StateValue:  types.BoolValue(true),
+				PlanValue:   types.BoolValue(false),
+				ConfigValue: types.BoolValue(false),
+			},
+			equivalent:  true,
+			notComputed: true,
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.request.Path = path.Root("testattr")
+			testCase.request.Plan = tfsdk.Plan{
+				Schema: testSchema(!testCase.notComputed),
+			}
+
+			resp := &planmodifier.BoolResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			equivalent := func(_ context.Context, stateValue types.Bool, configValue types.Bool) bool {
+				if stateValue.IsNull() || stateValue.IsUnknown() || configValue.IsNull() || configValue.IsUnknown() {
+					t.Fatalf("unexpected null or unknown value: %s, %s", stateValue, configValue)
+				}
+
+				return testCase.equivalent
+			}
+
+			boolplanmodifier.SuppressEquivalentDiff(equivalent).PlanModifyBool(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boolplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UseNullForNullConfig returns a plan modifier that sets the planned value to
+// null when the configuration value is null and there is a prior state value.
+// Use this when removing the attribute from the configuration should remove
+// the value from the resource during update, such as detaching an associated
+// infrastructure object.
+//
+// Without this plan modifier, unconfigured and Computed attributes are either
+// planned as an unknown value "(known after apply)" or, with other plan
+// modifiers such as UseStateForUnknown, the prior state value. Using this plan
+// modifier will instead display null in the plan, which the resource must then
+// save into the new state after applying the update.
+func UseNullForNullConfig() planmodifier.Bool {
+	return useNullForNullConfigModifier{}
+}
+
+// useNullForNullConfigModifier implements the plan modifier.
+type useNullForNullConfigModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m useNullForNullConfigModifier) Description(_ context.Context) string {
+	return "If removed from the configuration, the value of this attribute in state will be removed."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m useNullForNullConfigModifier) MarkdownDescription(_ context.Context) string {
+	return "If removed from the configuration, the value of this attribute in state will be removed."
+}
+
+// PlanModifyBool implements the plan modification logic.
+func (m useNullForNullConfigModifier) PlanModifyBool(_ context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	// Do nothing if there is no state value, such as on resource creation.
+	if req.StateValue.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a configuration value, including unknown.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	resp.PlanValue = types.BoolNull()
+}
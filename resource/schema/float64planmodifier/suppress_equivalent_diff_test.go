@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressEquivalentDiffModifierPlanModifyFloat64(t *testing.T) {
+	t.Parallel()
+
+	testSchema := func(computed bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"testattr": schema.Float64Attribute{
+					Optional: true,
+					Computed: computed,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		request     planmodifier.Float64Request
+		equivalent  bool
+		notComputed bool
+		expected    *planmodifier.Float64Response
+	}{
+		"null-state": {
+			// no prior state, such as on resource creation
+			request: planmodifier.Float64Request{
+				StateValue:  types.Float64Null(),
+				PlanValue:   types.Float64Value(1.5),
+				ConfigValue: types.Float64Value(1.5),
+			},
+			equivalent: true,
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.5),
+			},
+		},
+		"null-config": {
+			request: planmodifier.Float64Request{
+				StateValue:  types.Float64Value(1.0),
+				PlanValue:   types.Float64Unknown(),
+				ConfigValue: types.Float64Null(),
+			},
+			equivalent: true,
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.Float64Request{
+				StateValue:  types.Float64Value(1.0),
+				PlanValue:   types.Float64Unknown(),
+				ConfigValue: types.Float64Unknown(),
+			},
+			equivalent: true,
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"equivalent": {
+			// the diff is suppressed by keeping the prior state value
+			request: planmodifier.Float64Request{
+				StateValue:  types.Float64Value(1.0),
+				PlanValue:   types.Float64Value(1.5),
+				ConfigValue: types.Float64Value(1.5),
+			},
+			equivalent: true,
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.0),
+			},
+		},
+		"not-equivalent": {
+			request: planmodifier.Float64Request{
+				StateValue:  types.Float64Value(1.0),
+				PlanValue:   types.Float64Value(1.5),
+				ConfigValue: types.Float64Value(1.5),
+			},
+			equivalent: false,
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.5),
+			},
+		},
+		"not-computed": {
+			request: planmodifier.Float64Request{
+				StateValue:  types.Float64Value(1.0),
+				PlanValue:   types.Float64Value(1.5),
+				ConfigValue: types.Float64Value(1.5),
+			},
+			equivalent:  true,
+			notComputed: true,
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.0),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.request.Path = path.Root("testattr")
+			testCase.request.Plan = tfsdk.Plan{
+				Schema: testSchema(!testCase.notComputed),
+			}
+
+			resp := &planmodifier.Float64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			equivalent := func(_ context.Context, stateValue types.Float64, configValue types.Float64) bool {
+				if stateValue.IsNull() || stateValue.IsUnknown() || configValue.IsNull() || configValue.IsUnknown() {
+					t.Fatalf("unexpected null or unknown value: %s, %s", stateValue, configValue)
+				}
+
+				return testCase.equivalent
+			}
+
+			float64planmodifier.SuppressEquivalentDiff(equivalent).PlanModifyFloat64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
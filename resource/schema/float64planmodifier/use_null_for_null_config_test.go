@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUseNullForNullConfigModifierPlanModifyFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.Float64Request
+		expected *planmodifier.Float64Response
+	}{
+		"null-state": {
+			// when we first create the resource, use the unknown
+			// value
+			request: planmodifier.Float64Request{
+				StateValue:  types.Float64Null(),
+				PlanValue:   types.Float64Unknown(),
+				ConfigValue: types.Float64Null(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"known-config": {
+			request: planmodifier.Float64Request{
+				StateValue:  types.Float64Value(1.2),
+				PlanValue:   types.Float64Value(1.2),
+				ConfigValue: types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.Float64Request{
+				StateValue:  types.Float64Value(1.2),
+				PlanValue:   types.Float64Unknown(),
+				ConfigValue: types.Float64Unknown(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"non-null-state-null-config-unknown-plan": {
+			// this is the situation we want to remove the value
+			// from state
+			request: planmodifier.Float64Request{
+				StateValue:  types.Float64Value(1.2),
+				PlanValue:   types.Float64Unknown(),
+				ConfigValue: types.Float64Null(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Null(),
+			},
+		},
+		"non-null-state-null-config-state-plan": {
+			// a prior plan modifier, such as UseStateForUnknown,
+			// copied the prior state value into the plan
+			request: planmodifier.Float64Request{
+				StateValue:  types.Float64Value(1.2),
+				PlanValue:   types.Float64Value(1.2),
+				ConfigValue: types.Float64Null(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Null(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			float64planmodifier.UseNullForNullConfig().PlanModifyFloat64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
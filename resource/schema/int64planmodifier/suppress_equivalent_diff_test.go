@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressEquivalentDiffModifierPlanModifyInt64(t *testing.T) {
+	t.Parallel()
+
+	testSchema := func(computed bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"testattr": schema.Int64Attribute{
+					Optional: true,
+					Computed: computed,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		request     planmodifier.Int64Request
+		equivalent  bool
+		notComputed bool
+		expected    *planmodifier.Int64Response
+	}{
+		"null-state": {
+			// no prior state, such as on resource creation
+			request: planmodifier.Int64Request{
+				StateValue:  types.Int64Null(),
+				PlanValue:   types.Int64Value(2),
+				ConfigValue: types.Int64Value(2),
+			},
+			equivalent: true,
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(2),
+			},
+		},
+		"null-config": {
+			request: planmodifier.Int64Request{
+				StateValue:  types.Int64Value(1),
+				PlanValue:   types.Int64Unknown(),
+				ConfigValue: types.Int64Null(),
+			},
+			equivalent: true,
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Unknown(),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.Int64Request{
+				StateValue:  types.Int64Value(1),
+				PlanValue:   types.Int64Unknown(),
+				ConfigValue: types.Int64Unknown(),
+			},
+			equivalent: true,
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Unknown(),
+			},
+		},
+		"equivalent": {
+			// the diff is suppressed by keeping the prior state value
+			request: planmodifier.Int64Request{
+				StateValue:  types.Int64Value(1),
+				PlanValue:   types.Int64Value(2),
+				ConfigValue: types.Int64Value(2),
+			},
+			equivalent: true,
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(1),
+			},
+		},
+		"not-equivalent": {
+			request: planmodifier.Int64Request{
+				StateValue:  types.Int64Value(1),
+				PlanValue:   types.Int64Value(2),
+				ConfigValue: types.Int64Value(2),
+			},
+			equivalent: false,
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(2),
+			},
+		},
+		"not-computed": {
+			request: planmodifier.Int64Request{
+				StateValue:  types.Int64Value(1),
+				PlanValue:   types.Int64Value(2),
+				ConfigValue: types.Int64Value(2),
+			},
+			equivalent:  true,
+			notComputed: true,
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(1),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.request.Path = path.Root("testattr")
+			testCase.request.Plan = tfsdk.Plan{
+				Schema: testSchema(!testCase.notComputed),
+			}
+
+			resp := &planmodifier.Int64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			equivalent := func(_ context.Context, stateValue types.Int64, configValue types.Int64) bool {
+				if stateValue.IsNull() || stateValue.IsUnknown() || configValue.IsNull() || configValue.IsUnknown() {
+					t.Fatalf("unexpected null or unknown value: %s, %s", stateValue, configValue)
+				}
+
+				return testCase.equivalent
+			}
+
+			int64planmodifier.SuppressEquivalentDiff(equivalent).PlanModifyInt64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
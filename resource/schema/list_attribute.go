@@ -25,6 +25,7 @@ var (
 	_ fwschema.AttributeWithListDefaultValue       = ListAttribute{}
 	_ fwxschema.AttributeWithListPlanModifiers     = ListAttribute{}
 	_ fwxschema.AttributeWithListValidators        = ListAttribute{}
+	_ fwschema.AttributeWithTreatEmptyAsNull       = ListAttribute{}
 )
 
 // ListAttribute represents a schema attribute that is a list with a single
@@ -163,6 +164,18 @@ type ListAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.List
+
+	// TreatEmptyAsNull indicates whether an empty list value and a null value
+	// should be considered equivalent for this attribute when saving
+	// resource state, such as when the remote system does not distinguish
+	// between an unset and empty list. When enabled and the resource Create
+	// or Update logic saves an empty value while the plan value is null, or
+	// the resource Read logic saves an empty value while the prior state
+	// value is null, the framework saves a null value instead. Similarly,
+	// a null value is saved as an empty value if the plan or prior state
+	// value is empty. This prevents Terraform from reporting unexpected
+	// differences between the two representations.
+	TreatEmptyAsNull bool
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a list
@@ -196,6 +209,11 @@ func (a ListAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetTreatEmptyAsNull returns the TreatEmptyAsNull field value.
+func (a ListAttribute) GetTreatEmptyAsNull() bool {
+	return a.TreatEmptyAsNull
+}
+
 // GetType returns types.ListType or the CustomType field value if defined.
 func (a ListAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -26,6 +26,7 @@ var (
 	_ fwschema.AttributeWithListDefaultValue       = ListNestedAttribute{}
 	_ fwxschema.AttributeWithListPlanModifiers     = ListNestedAttribute{}
 	_ fwxschema.AttributeWithListValidators        = ListNestedAttribute{}
+	_ fwschema.AttributeWithTreatEmptyAsNull       = ListNestedAttribute{}
 )
 
 // ListNestedAttribute represents an attribute that is a list of objects where
@@ -173,6 +174,18 @@ type ListNestedAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.List
+
+	// TreatEmptyAsNull indicates whether an empty list value and a null value
+	// should be considered equivalent for this attribute when saving
+	// resource state, such as when the remote system does not distinguish
+	// between an unset and empty list. When enabled and the resource Create
+	// or Update logic saves an empty value while the plan value is null, or
+	// the resource Read logic saves an empty value while the prior state
+	// value is null, the framework saves a null value instead. Similarly,
+	// a null value is saved as an empty value if the plan or prior state
+	// value is empty. This prevents Terraform from reporting unexpected
+	// differences between the two representations.
+	TreatEmptyAsNull bool
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -224,6 +237,11 @@ func (a ListNestedAttribute) GetNestingMode() fwschema.NestingMode {
 	return fwschema.NestingModeList
 }
 
+// GetTreatEmptyAsNull returns the TreatEmptyAsNull field value.
+func (a ListNestedAttribute) GetTreatEmptyAsNull() bool {
+	return a.TreatEmptyAsNull
+}
+
 // GetType returns ListType of ObjectType or CustomType.
 func (a ListNestedAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
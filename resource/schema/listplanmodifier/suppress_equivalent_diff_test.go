@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressEquivalentDiffModifierPlanModifyList(t *testing.T) {
+	t.Parallel()
+
+	testSchema := func(computed bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"testattr": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Computed:    computed,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		request     planmodifier.ListRequest
+		equivalent  bool
+		notComputed bool
+		expected    *planmodifier.ListResponse
+	}{
+		"null-state": {
+			// no prior state, such as on resource creation
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListNull(types.StringType),
+				PlanValue:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+				ConfigValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+			equivalent: true,
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+		},
+		"null-config": {
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.ListUnknown(types.StringType),
+				ConfigValue: types.ListNull(types.StringType),
+			},
+			equivalent: true,
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.ListUnknown(types.StringType),
+				ConfigValue: types.ListUnknown(types.StringType),
+			},
+			equivalent: true,
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"equivalent": {
+			// the diff is suppressed by keeping the prior state value
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+				ConfigValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+			equivalent: true,
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"not-equivalent": {
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+				ConfigValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+			equivalent: false,
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+		},
+		"not-computed": {
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+				ConfigValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+			equivalent:  true,
+			notComputed: true,
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.request.Path = path.Root("testattr")
+			testCase.request.Plan = tfsdk.Plan{
+				Schema: testSchema(!testCase.notComputed),
+			}
+
+			resp := &planmodifier.ListResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			equivalent := func(_ context.Context, stateValue types.List, configValue types.List) bool {
+				if stateValue.IsNull() || stateValue.IsUnknown() || configValue.IsNull() || configValue.IsUnknown() {
+					t.Fatalf("unexpected null or unknown value: %s, %s", stateValue, configValue)
+				}
+
+				return testCase.equivalent
+			}
+
+			listplanmodifier.SuppressEquivalentDiff(equivalent).PlanModifyList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
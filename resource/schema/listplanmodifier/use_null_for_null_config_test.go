@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUseNullForNullConfigModifierPlanModifyList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.ListRequest
+		expected *planmodifier.ListResponse
+	}{
+		"null-state": {
+			// when we first create the resource, use the unknown
+			// value
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListNull(types.StringType),
+				PlanValue:   types.ListUnknown(types.StringType),
+				ConfigValue: types.ListNull(types.StringType),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"known-config": {
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				ConfigValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.ListUnknown(types.StringType),
+				ConfigValue: types.ListUnknown(types.StringType),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"non-null-state-null-config-unknown-plan": {
+			// this is the situation we want to remove the value
+			// from state
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.ListUnknown(types.StringType),
+				ConfigValue: types.ListNull(types.StringType),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListNull(types.StringType),
+			},
+		},
+		"non-null-state-null-config-state-plan": {
+			// a prior plan modifier, such as UseStateForUnknown,
+			// copied the prior state value into the plan
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				ConfigValue: types.ListNull(types.StringType),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListNull(types.StringType),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.ListResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			listplanmodifier.UseNullForNullConfig().PlanModifyList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
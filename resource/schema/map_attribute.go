@@ -25,6 +25,7 @@ var (
 	_ fwschema.AttributeWithMapDefaultValue        = MapAttribute{}
 	_ fwxschema.AttributeWithMapPlanModifiers      = MapAttribute{}
 	_ fwxschema.AttributeWithMapValidators         = MapAttribute{}
+	_ fwschema.AttributeWithTreatEmptyAsNull       = MapAttribute{}
 )
 
 // MapAttribute represents a schema attribute that is a list with a single
@@ -166,6 +167,18 @@ type MapAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Map
+
+	// TreatEmptyAsNull indicates whether an empty map value and a null value
+	// should be considered equivalent for this attribute when saving
+	// resource state, such as when the remote system does not distinguish
+	// between an unset and empty map. When enabled and the resource Create
+	// or Update logic saves an empty value while the plan value is null, or
+	// the resource Read logic saves an empty value while the prior state
+	// value is null, the framework saves a null value instead. Similarly,
+	// a null value is saved as an empty value if the plan or prior state
+	// value is empty. This prevents Terraform from reporting unexpected
+	// differences between the two representations.
+	TreatEmptyAsNull bool
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a map
@@ -199,6 +212,11 @@ func (a MapAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetTreatEmptyAsNull returns the TreatEmptyAsNull field value.
+func (a MapAttribute) GetTreatEmptyAsNull() bool {
+	return a.TreatEmptyAsNull
+}
+
 // GetType returns types.MapType or the CustomType field value if defined.
 func (a MapAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -232,6 +232,41 @@ func TestMapAttributeGetMarkdownDescription(t *testing.T) {
 	}
 }
 
+func TestMapAttributeGetTreatEmptyAsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.MapAttribute
+		expected  bool
+	}{
+		"not-treat-empty-as-null": {
+			attribute: schema.MapAttribute{ElementType: types.StringType},
+			expected:  false,
+		},
+		"treat-empty-as-null": {
+			attribute: schema.MapAttribute{
+				ElementType:      types.StringType,
+				TreatEmptyAsNull: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetTreatEmptyAsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapAttributeGetType(t *testing.T) {
 	t.Parallel()
 
@@ -26,6 +26,7 @@ var (
 	_ fwschema.AttributeWithMapDefaultValue        = MapNestedAttribute{}
 	_ fwxschema.AttributeWithMapPlanModifiers      = MapNestedAttribute{}
 	_ fwxschema.AttributeWithMapValidators         = MapNestedAttribute{}
+	_ fwschema.AttributeWithTreatEmptyAsNull       = MapNestedAttribute{}
 )
 
 // MapNestedAttribute represents an attribute that is a set of objects where
@@ -173,6 +174,18 @@ type MapNestedAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Map
+
+	// TreatEmptyAsNull indicates whether an empty map value and a null value
+	// should be considered equivalent for this attribute when saving
+	// resource state, such as when the remote system does not distinguish
+	// between an unset and empty map. When enabled and the resource Create
+	// or Update logic saves an empty value while the plan value is null, or
+	// the resource Read logic saves an empty value while the prior state
+	// value is null, the framework saves a null value instead. Similarly,
+	// a null value is saved as an empty value if the plan or prior state
+	// value is empty. This prevents Terraform from reporting unexpected
+	// differences between the two representations.
+	TreatEmptyAsNull bool
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -224,6 +237,11 @@ func (a MapNestedAttribute) GetNestingMode() fwschema.NestingMode {
 	return fwschema.NestingModeMap
 }
 
+// GetTreatEmptyAsNull returns the TreatEmptyAsNull field value.
+func (a MapNestedAttribute) GetTreatEmptyAsNull() bool {
+	return a.TreatEmptyAsNull
+}
+
 // GetType returns MapType of ObjectType or CustomType.
 func (a MapNestedAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
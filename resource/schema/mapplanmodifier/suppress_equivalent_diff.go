@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SuppressEquivalentDiffFunc is an equivalence function used in the
+// SuppressEquivalentDiff plan modifier to determine whether the prior state
+// value and configuration value are equivalent. Both values are always known
+// and not null.
+type SuppressEquivalentDiffFunc func(ctx context.Context, stateValue types.Map, configValue types.Map) bool
+
+// SuppressEquivalentDiff returns a plan modifier that copies the prior state
+// value into the planned value when the given function determines the prior
+// state value and configuration value are equivalent. Use this when the
+// remote system normalizes values, so equivalent configuration values do not
+// show a difference in the plan.
+//
+// The plan modifier does nothing if either the prior state value or
+// configuration value is null or unknown.
+func SuppressEquivalentDiff(f SuppressEquivalentDiffFunc) planmodifier.Map {
+	return suppressEquivalentDiffModifier{
+		equivalent: f,
+	}
+}
+
+// suppressEquivalentDiffModifier implements the plan modifier.
+type suppressEquivalentDiffModifier struct {
+	equivalent SuppressEquivalentDiffFunc
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m suppressEquivalentDiffModifier) Description(_ context.Context) string {
+	return "If the configured value is equivalent to the value in state, the value in state will not change."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m suppressEquivalentDiffModifier) MarkdownDescription(_ context.Context) string {
+	return "If the configured value is equivalent to the value in state, the value in state will not change."
+}
+
+// PlanModifyMap implements the plan modification logic.
+func (m suppressEquivalentDiffModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	// Do nothing if there is no state value, such as on resource creation.
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if there is no configuration value to compare.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !m.equivalent(ctx, req.StateValue, req.ConfigValue) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressEquivalentDiffModifierPlanModifyMap(t *testing.T) {
+	t.Parallel()
+
+	testSchema := func(computed bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"testattr": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Computed:    computed,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		request     planmodifier.MapRequest
+		equivalent  bool
+		notComputed bool
+		expected    *planmodifier.MapResponse
+	}{
+		"null-state": {
+			// no prior state, such as on resource creation
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapNull(types.StringType),
+				PlanValue:   types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("TEST")}),
+				ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("TEST")}),
+			},
+			equivalent: true,
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("TEST")}),
+			},
+		},
+		"null-config": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapUnknown(types.StringType),
+				ConfigValue: types.MapNull(types.StringType),
+			},
+			equivalent: true,
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapUnknown(types.StringType),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapUnknown(types.StringType),
+				ConfigValue: types.MapUnknown(types.StringType),
+			},
+			equivalent: true,
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapUnknown(types.StringType),
+			},
+		},
+		"equivalent": {
+			// the diff is suppressed by keeping the prior state value
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("TEST")}),
+				ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("TEST")}),
+			},
+			equivalent: true,
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+			},
+		},
+		"not-equivalent": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("TEST")}),
+				ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("TEST")}),
+			},
+			equivalent: false,
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("TEST")}),
+			},
+		},
+		"not-computed": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("TEST")}),
+				ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("TEST")}),
+			},
+			equivalent:  true,
+			notComputed: true,
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.request.Path = path.Root("testattr")
+			testCase.request.Plan = tfsdk.Plan{
+				Schema: testSchema(!testCase.notComputed),
+			}
+
+			resp := &planmodifier.MapResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			equivalent := func(_ context.Context, stateValue types.Map, configValue types.Map) bool {
+				if stateValue.IsNull() || stateValue.IsUnknown() || configValue.IsNull() || configValue.IsUnknown() {
+					t.Fatalf("unexpected null or unknown value: %s, %s", stateValue, configValue)
+				}
+
+				return testCase.equivalent
+			}
+
+			mapplanmodifier.SuppressEquivalentDiff(equivalent).PlanModifyMap(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUseNullForNullConfigModifierPlanModifyMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.MapRequest
+		expected *planmodifier.MapResponse
+	}{
+		"null-state": {
+			// when we first create the resource, use the unknown
+			// value
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapNull(types.StringType),
+				PlanValue:   types.MapUnknown(types.StringType),
+				ConfigValue: types.MapNull(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapUnknown(types.StringType),
+			},
+		},
+		"known-config": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapUnknown(types.StringType),
+				ConfigValue: types.MapUnknown(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapUnknown(types.StringType),
+			},
+		},
+		"non-null-state-null-config-unknown-plan": {
+			// this is the situation we want to remove the value
+			// from state
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapUnknown(types.StringType),
+				ConfigValue: types.MapNull(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapNull(types.StringType),
+			},
+		},
+		"non-null-state-null-config-state-plan": {
+			// a prior plan modifier, such as UseStateForUnknown,
+			// copied the prior state value into the plan
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				ConfigValue: types.MapNull(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapNull(types.StringType),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.MapResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			mapplanmodifier.UseNullForNullConfig().PlanModifyMap(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numberplanmodifier_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressEquivalentDiffModifierPlanModifyNumber(t *testing.T) {
+	t.Parallel()
+
+	testSchema := func(computed bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"testattr": schema.NumberAttribute{
+					Optional: true,
+					Computed: computed,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		request     planmodifier.NumberRequest
+		equivalent  bool
+		notComputed bool
+		expected    *planmodifier.NumberResponse
+	}{
+		"null-state": {
+			// no prior state, such as on resource creation
+			request: planmodifier.NumberRequest{
+				StateValue:  types.NumberNull(),
+				PlanValue:   types.NumberValue(big.NewFloat(1.5)),
+				ConfigValue: types.NumberValue(big.NewFloat(1.5)),
+			},
+			equivalent: true,
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1.5)),
+			},
+		},
+		"null-config": {
+			request: planmodifier.NumberRequest{
+				StateValue:  types.NumberValue(big.NewFloat(1)),
+				PlanValue:   types.NumberUnknown(),
+				ConfigValue: types.NumberNull(),
+			},
+			equivalent: true,
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberUnknown(),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.NumberRequest{
+				StateValue:  types.NumberValue(big.NewFloat(1)),
+				PlanValue:   types.NumberUnknown(),
+				ConfigValue: types.NumberUnknown(),
+			},
+			equivalent: true,
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberUnknown(),
+			},
+		},
+		"equivalent": {
+			// the diff is suppressed by keeping the prior state value
+			request: planmodifier.NumberRequest{
+				StateValue:  types.NumberValue(big.NewFloat(1)),
+				PlanValue:   types.NumberValue(big.NewFloat(1.5)),
+				ConfigValue: types.NumberValue(big.NewFloat(1.5)),
+			},
+			equivalent: true,
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1)),
+			},
+		},
+		"not-equivalent": {
+			request: planmodifier.NumberRequest{
+				StateValue:  types.NumberValue(big.NewFloat(1)),
+				PlanValue:   types.NumberValue(big.NewFloat(1.5)),
+				ConfigValue: types.NumberValue(big.NewFloat(1.5)),
+			},
+			equivalent: false,
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1.5)),
+			},
+		},
+		"not-computed": {
+			request: planmodifier.NumberRequest{
+				StateValue:  types.NumberValue(big.NewFloat(1)),
+				PlanValue:   types.NumberValue(big.NewFloat(1.5)),
+				ConfigValue: types.NumberValue(big.NewFloat(1.5)),
+			},
+			equivalent:  true,
+			notComputed: true,
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.request.Path = path.Root("testattr")
+			testCase.request.Plan = tfsdk.Plan{
+				Schema: testSchema(!testCase.notComputed),
+			}
+
+			resp := &planmodifier.NumberResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			equivalent := func(_ context.Context, stateValue types.Number, configValue types.Number) bool {
+				if stateValue.IsNull() || stateValue.IsUnknown() || configValue.IsNull() || configValue.IsUnknown() {
+					t.Fatalf("unexpected null or unknown value: %s, %s", stateValue, configValue)
+				}
+
+				return testCase.equivalent
+			}
+
+			numberplanmodifier.SuppressEquivalentDiff(equivalent).PlanModifyNumber(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package objectplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressEquivalentDiffModifierPlanModifyObject(t *testing.T) {
+	t.Parallel()
+
+	testSchema := func(computed bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"testattr": schema.ObjectAttribute{
+					AttributeTypes: map[string]attr.Type{"attr": types.StringType},
+					Optional:       true,
+					Computed:       computed,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		request     planmodifier.ObjectRequest
+		equivalent  bool
+		notComputed bool
+		expected    *planmodifier.ObjectResponse
+	}{
+		"null-state": {
+			// no prior state, such as on resource creation
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectNull(map[string]attr.Type{"attr": types.StringType}),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("TEST")}),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("TEST")}),
+			},
+			equivalent: true,
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("TEST")}),
+			},
+		},
+		"null-config": {
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("test")}),
+				PlanValue:   types.ObjectUnknown(map[string]attr.Type{"attr": types.StringType}),
+				ConfigValue: types.ObjectNull(map[string]attr.Type{"attr": types.StringType}),
+			},
+			equivalent: true,
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{"attr": types.StringType}),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("test")}),
+				PlanValue:   types.ObjectUnknown(map[string]attr.Type{"attr": types.StringType}),
+				ConfigValue: types.ObjectUnknown(map[string]attr.Type{"attr": types.StringType}),
+			},
+			equivalent: true,
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{"attr": types.StringType}),
+			},
+		},
+		"equivalent": {
+			// the diff is suppressed by keeping the prior state value
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("test")}),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("TEST")}),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("TEST")}),
+			},
+			equivalent: true,
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("test")}),
+			},
+		},
+		"not-equivalent": {
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("test")}),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("TEST")}),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("TEST")}),
+			},
+			equivalent: false,
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("TEST")}),
+			},
+		},
+		"not-computed": {
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("test")}),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("TEST")}),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("TEST")}),
+			},
+			equivalent:  true,
+			notComputed: true,
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("test")}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.request.Path = path.Root("testattr")
+			testCase.request.Plan = tfsdk.Plan{
+				Schema: testSchema(!testCase.notComputed),
+			}
+
+			resp := &planmodifier.ObjectResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			equivalent := func(_ context.Context, stateValue types.Object, configValue types.Object) bool {
+				if stateValue.IsNull() || stateValue.IsUnknown() || configValue.IsNull() || configValue.IsUnknown() {
+					t.Fatalf("unexpected null or unknown value: %s, %s", stateValue, configValue)
+				}
+
+				return testCase.equivalent
+			}
+
+			objectplanmodifier.SuppressEquivalentDiff(equivalent).PlanModifyObject(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
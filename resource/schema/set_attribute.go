@@ -25,6 +25,7 @@ var (
 	_ fwschema.AttributeWithSetDefaultValue        = SetAttribute{}
 	_ fwxschema.AttributeWithSetPlanModifiers      = SetAttribute{}
 	_ fwxschema.AttributeWithSetValidators         = SetAttribute{}
+	_ fwschema.AttributeWithTreatEmptyAsNull       = SetAttribute{}
 )
 
 // SetAttribute represents a schema attribute that is a set with a single
@@ -161,6 +162,18 @@ type SetAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Set
+
+	// TreatEmptyAsNull indicates whether an empty set value and a null value
+	// should be considered equivalent for this attribute when saving
+	// resource state, such as when the remote system does not distinguish
+	// between an unset and empty set. When enabled and the resource Create
+	// or Update logic saves an empty value while the plan value is null, or
+	// the resource Read logic saves an empty value while the prior state
+	// value is null, the framework saves a null value instead. Similarly,
+	// a null value is saved as an empty value if the plan or prior state
+	// value is empty. This prevents Terraform from reporting unexpected
+	// differences between the two representations.
+	TreatEmptyAsNull bool
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a set
@@ -194,6 +207,11 @@ func (a SetAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetTreatEmptyAsNull returns the TreatEmptyAsNull field value.
+func (a SetAttribute) GetTreatEmptyAsNull() bool {
+	return a.TreatEmptyAsNull
+}
+
 // GetType returns types.SetType or the CustomType field value if defined.
 func (a SetAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
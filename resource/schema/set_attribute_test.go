@@ -231,6 +231,41 @@ func TestSetAttributeGetMarkdownDescription(t *testing.T) {
 	}
 }
 
+func TestSetAttributeGetTreatEmptyAsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.SetAttribute
+		expected  bool
+	}{
+		"not-treat-empty-as-null": {
+			attribute: schema.SetAttribute{ElementType: types.StringType},
+			expected:  false,
+		},
+		"treat-empty-as-null": {
+			attribute: schema.SetAttribute{
+				ElementType:      types.StringType,
+				TreatEmptyAsNull: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetTreatEmptyAsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSetAttributeGetType(t *testing.T) {
 	t.Parallel()
 
@@ -26,6 +26,7 @@ var (
 	_ fwschema.AttributeWithSetDefaultValue        = SetNestedAttribute{}
 	_ fwxschema.AttributeWithSetPlanModifiers      = SetNestedAttribute{}
 	_ fwxschema.AttributeWithSetValidators         = SetNestedAttribute{}
+	_ fwschema.AttributeWithTreatEmptyAsNull       = SetNestedAttribute{}
 )
 
 // SetNestedAttribute represents an attribute that is a set of objects where
@@ -168,6 +169,18 @@ type SetNestedAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Set
+
+	// TreatEmptyAsNull indicates whether an empty set value and a null value
+	// should be considered equivalent for this attribute when saving
+	// resource state, such as when the remote system does not distinguish
+	// between an unset and empty set. When enabled and the resource Create
+	// or Update logic saves an empty value while the plan value is null, or
+	// the resource Read logic saves an empty value while the prior state
+	// value is null, the framework saves a null value instead. Similarly,
+	// a null value is saved as an empty value if the plan or prior state
+	// value is empty. This prevents Terraform from reporting unexpected
+	// differences between the two representations.
+	TreatEmptyAsNull bool
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -219,6 +232,11 @@ func (a SetNestedAttribute) GetNestingMode() fwschema.NestingMode {
 	return fwschema.NestingModeSet
 }
 
+// GetTreatEmptyAsNull returns the TreatEmptyAsNull field value.
+func (a SetNestedAttribute) GetTreatEmptyAsNull() bool {
+	return a.TreatEmptyAsNull
+}
+
 // GetType returns SetType of ObjectType or CustomType.
 func (a SetNestedAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package setplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressEquivalentDiffModifierPlanModifySet(t *testing.T) {
+	t.Parallel()
+
+	testSchema := func(computed bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"testattr": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Computed:    computed,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		request     planmodifier.SetRequest
+		equivalent  bool
+		notComputed bool
+		expected    *planmodifier.SetResponse
+	}{
+		"null-state": {
+			// no prior state, such as on resource creation
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetNull(types.StringType),
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+				ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+			equivalent: true,
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+		},
+		"null-config": {
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.SetUnknown(types.StringType),
+				ConfigValue: types.SetNull(types.StringType),
+			},
+			equivalent: true,
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(types.StringType),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.SetUnknown(types.StringType),
+				ConfigValue: types.SetUnknown(types.StringType),
+			},
+			equivalent: true,
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(types.StringType),
+			},
+		},
+		"equivalent": {
+			// the diff is suppressed by keeping the prior state value
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+				ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+			equivalent: true,
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"not-equivalent": {
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+				ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+			equivalent: false,
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+		},
+		"not-computed": {
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+				ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("TEST")}),
+			},
+			equivalent:  true,
+			notComputed: true,
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.request.Path = path.Root("testattr")
+			testCase.request.Plan = tfsdk.Plan{
+				Schema: testSchema(!testCase.notComputed),
+			}
+
+			resp := &planmodifier.SetResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			equivalent := func(_ context.Context, stateValue types.Set, configValue types.Set) bool {
+				if stateValue.IsNull() || stateValue.IsUnknown() || configValue.IsNull() || configValue.IsUnknown() {
+					t.Fatalf("unexpected null or unknown value: %s, %s", stateValue, configValue)
+				}
+
+				return testCase.equivalent
+			}
+
+			setplanmodifier.SuppressEquivalentDiff(equivalent).PlanModifySet(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
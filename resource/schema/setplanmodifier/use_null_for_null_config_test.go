@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package setplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUseNullForNullConfigModifierPlanModifySet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.SetRequest
+		expected *planmodifier.SetResponse
+	}{
+		"null-state": {
+			// when we first create the resource, use the unknown
+			// value
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetNull(types.StringType),
+				PlanValue:   types.SetUnknown(types.StringType),
+				ConfigValue: types.SetNull(types.StringType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(types.StringType),
+			},
+		},
+		"known-config": {
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.SetUnknown(types.StringType),
+				ConfigValue: types.SetUnknown(types.StringType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(types.StringType),
+			},
+		},
+		"non-null-state-null-config-unknown-plan": {
+			// this is the situation we want to remove the value
+			// from state
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.SetUnknown(types.StringType),
+				ConfigValue: types.SetNull(types.StringType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetNull(types.StringType),
+			},
+		},
+		"non-null-state-null-config-state-plan": {
+			// a prior plan modifier, such as UseStateForUnknown,
+			// copied the prior state value into the plan
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				ConfigValue: types.SetNull(types.StringType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetNull(types.StringType),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.SetResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			setplanmodifier.UseNullForNullConfig().PlanModifySet(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
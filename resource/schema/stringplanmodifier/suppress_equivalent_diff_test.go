@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressEquivalentDiffModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := func(computed bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"testattr": schema.StringAttribute{
+					Optional: true,
+					Computed: computed,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		request     planmodifier.StringRequest
+		equivalent  bool
+		notComputed bool
+		expected    *planmodifier.StringResponse
+	}{
+		"null-state": {
+			// no prior state, such as on resource creation
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringValue("TEST"),
+				ConfigValue: types.StringValue("TEST"),
+			},
+			equivalent: true,
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("TEST"),
+			},
+		},
+		"null-config": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("test"),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			},
+			equivalent: true,
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("test"),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringUnknown(),
+			},
+			equivalent: true,
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"equivalent": {
+			// the diff is suppressed by keeping the prior state value
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("test"),
+				PlanValue:   types.StringValue("TEST"),
+				ConfigValue: types.StringValue("TEST"),
+			},
+			equivalent: true,
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"not-equivalent": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("test"),
+				PlanValue:   types.StringValue("TEST"),
+				ConfigValue: types.StringValue("TEST"),
+			},
+			equivalent: false,
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("TEST"),
+			},
+		},
+		"not-computed": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("test"),
+				PlanValue:   types.StringValue("TEST"),
+				ConfigValue: types.StringValue("TEST"),
+			},
+			equivalent:  true,
+			notComputed: true,
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.request.Path = path.Root("testattr")
+			testCase.request.Plan = tfsdk.Plan{
+				Schema: testSchema(!testCase.notComputed),
+			}
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			equivalent := func(_ context.Context, stateValue types.String, configValue types.String) bool {
+				if stateValue.IsNull() || stateValue.IsUnknown() || configValue.IsNull() || configValue.IsUnknown() {
+					t.Fatalf("unexpected null or unknown value: %s, %s", stateValue, configValue)
+				}
+
+				return testCase.equivalent
+			}
+
+			stringplanmodifier.SuppressEquivalentDiff(equivalent).PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timeouts provides a resource schema block and value type for
+// practitioner configurable create, read, update, and delete operation
+// timeouts.
+//
+// Add the timeouts block to the resource schema via the Block function, then
+// read the value into the Value type, such as a resource data model field.
+// Call the operation specific methods, such as Create, to get the configured
+// duration or a given default duration.
+//
+//	type ThingResourceModel struct {
+//		Name     types.String   `tfsdk:"name"`
+//		Timeouts timeouts.Value `tfsdk:"timeouts"`
+//	}
+//
+//	createTimeout, diags := data.Timeouts.Create(ctx, 20*time.Minute)
+//
+//	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+//	defer cancel()
+package timeouts
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	attributeNameCreate = "create"
+	attributeNameRead   = "read"
+	attributeNameUpdate = "update"
+	attributeNameDelete = "delete"
+)
+
+// Opts is used as an argument to Block to indicate which operations should
+// have a configurable timeout.
+type Opts struct {
+	Create bool
+	Read   bool
+	Update bool
+	Delete bool
+}
+
+// Block returns a resource schema block, which is expected to be added to the
+// resource schema Blocks field with the "timeouts" name. Each enabled operation is an
+// optional string attribute, which accepts a positive Go duration string such
+// as "30s" or "2h45m".
+func Block(ctx context.Context, opts Opts) schema.Block {
+	return schema.SingleNestedBlock{
+		Attributes: attributes(opts),
+		CustomType: Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: attributeTypes(opts),
+			},
+		},
+	}
+}
+
+// attributes returns the schema attributes for each enabled operation.
+func attributes(opts Opts) map[string]schema.Attribute {
+	result := map[string]schema.Attribute{}
+
+	for name, enabled := range operations(opts) {
+		if !enabled {
+			continue
+		}
+
+		result[name] = schema.StringAttribute{
+			Optional: true,
+			Description: `A string that can be parsed as a positive duration consisting of numbers and unit suffixes, ` +
+				`such as "30s" or "2h45m". ` + validTimeUnits,
+			Validators: []validator.String{
+				timeDurationValidator{},
+			},
+		}
+	}
+
+	return result
+}
+
+// attributeTypes returns the attribute types for each enabled operation.
+func attributeTypes(opts Opts) map[string]attr.Type {
+	result := map[string]attr.Type{}
+
+	for name, enabled := range operations(opts) {
+		if !enabled {
+			continue
+		}
+
+		result[name] = types.StringType
+	}
+
+	return result
+}
+
+// operations returns the mapping of attribute names to whether the operation
+// is enabled.
+func operations(opts Opts) map[string]bool {
+	return map[string]bool{
+		attributeNameCreate: opts.Create,
+		attributeNameRead:   opts.Read,
+		attributeNameUpdate: opts.Update,
+		attributeNameDelete: opts.Delete,
+	}
+}
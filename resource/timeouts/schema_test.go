@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     timeouts.Opts
+		expected map[string]attr.Type
+	}{
+		"empty": {
+			opts:     timeouts.Opts{},
+			expected: map[string]attr.Type{},
+		},
+		"create-update": {
+			opts: timeouts.Opts{
+				Create: true,
+				Update: true,
+			},
+			expected: map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+			},
+		},
+		"all": {
+			opts: timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			},
+			expected: map[string]attr.Type{
+				"create": types.StringType,
+				"read":   types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timeouts.Block(context.Background(), testCase.opts)
+
+			block, ok := got.(schema.SingleNestedBlock)
+
+			if !ok {
+				t.Fatalf("expected schema.SingleNestedBlock, got: %T", got)
+			}
+
+			if len(block.Attributes) != len(testCase.expected) {
+				t.Errorf("expected %d attributes, got: %d", len(testCase.expected), len(block.Attributes))
+			}
+
+			for name, attribute := range block.Attributes {
+				if !attribute.IsOptional() {
+					t.Errorf("expected attribute %s to be optional", name)
+				}
+			}
+
+			expectedType := timeouts.Type{
+				ObjectType: types.ObjectType{
+					AttrTypes: testCase.expected,
+				},
+			}
+
+			if diff := cmp.Diff(block.Type(), expectedType); diff != "" {
+				t.Errorf("unexpected type difference: %s", diff)
+			}
+		})
+	}
+}
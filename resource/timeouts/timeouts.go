@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.ObjectTypable  = Type{}
+	_ basetypes.ObjectValuable = Value{}
+)
+
+// Type is an attribute type that represents timeouts.
+type Type struct {
+	basetypes.ObjectType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t Type) Equal(o attr.Type) bool {
+	other, ok := o.(Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+// String returns a human readable string of the type name.
+func (t Type) String() string {
+	return "timeouts.Type"
+}
+
+// ValueFromObject returns a Value given a basetypes.ObjectValue.
+func (t Type) ValueFromObject(_ context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	return Value{
+		Object: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ObjectType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	objectValue, ok := attrValue.(basetypes.ObjectValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	objectValuable, diags := t.ValueFromObject(ctx, objectValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ObjectValue to ObjectValuable: %v", diags)
+	}
+
+	return objectValuable, nil
+}
+
+// ValueType returns the value type of Type, which is Value.
+func (t Type) ValueType(_ context.Context) attr.Value {
+	return Value{}
+}
+
+// Value represents an object containing values to be used as time.Duration
+// for timeouts.
+type Value struct {
+	types.Object
+}
+
+// Equal returns true if the given value is equivalent.
+func (t Value) Equal(o attr.Value) bool {
+	other, ok := o.(Value)
+
+	if !ok {
+		return false
+	}
+
+	return t.Object.Equal(other.Object)
+}
+
+// Type returns a Type with the same attribute types.
+func (t Value) Type(ctx context.Context) attr.Type {
+	return Type{
+		ObjectType: types.ObjectType{
+			AttrTypes: t.AttributeTypes(ctx),
+		},
+	}
+}
+
+// Create attempts to retrieve the "create" attribute and parse it as
+// time.Duration. If any diagnostics are generated they are returned along
+// with the supplied default timeout.
+func (t Value) Create(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return t.getTimeout(ctx, attributeNameCreate, defaultTimeout)
+}
+
+// Read attempts to retrieve the "read" attribute and parse it as
+// time.Duration. If any diagnostics are generated they are returned along
+// with the supplied default timeout.
+func (t Value) Read(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return t.getTimeout(ctx, attributeNameRead, defaultTimeout)
+}
+
+// Update attempts to retrieve the "update" attribute and parse it as
+// time.Duration. If any diagnostics are generated they are returned along
+// with the supplied default timeout.
+func (t Value) Update(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return t.getTimeout(ctx, attributeNameUpdate, defaultTimeout)
+}
+
+// Delete attempts to retrieve the "delete" attribute and parse it as
+// time.Duration. If any diagnostics are generated they are returned along
+// with the supplied default timeout.
+func (t Value) Delete(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return t.getTimeout(ctx, attributeNameDelete, defaultTimeout)
+}
+
+// getTimeout returns the parsed duration of the given attribute name, or the
+// default timeout if the attribute is missing, null, or unknown.
+func (t Value) getTimeout(_ context.Context, attributeName string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, ok := t.Object.Attributes()[attributeName]
+
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return defaultTimeout, diags
+	}
+
+	stringValue, ok := value.(types.String)
+
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("timeouts").AtName(attributeName),
+			"Timeout Cannot Be Parsed",
+			fmt.Sprintf("An unexpected timeout value type of %T was found. ", value)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return defaultTimeout, diags
+	}
+
+	duration, err := parseTimeout(stringValue.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("timeouts").AtName(attributeName),
+			"Timeout Cannot Be Parsed",
+			fmt.Sprintf("The %s timeout value must be a string that can be parsed as a positive duration, such as \"30s\" or \"2h45m\". ", attributeName)+
+				validTimeUnits+"\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return defaultTimeout, diags
+	}
+
+	return duration, diags
+}
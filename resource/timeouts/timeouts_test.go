@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueCreate(t *testing.T) {
+	t.Parallel()
+
+	testAttributeTypes := map[string]attr.Type{
+		"create": types.StringType,
+	}
+
+	testCases := map[string]struct {
+		value           timeouts.Value
+		expectedTimeout time.Duration
+		expectedDiags   diag.Diagnostics
+	}{
+		"create": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					testAttributeTypes,
+					map[string]attr.Value{
+						"create": types.StringValue("10m"),
+					},
+				),
+			},
+			expectedTimeout: 10 * time.Minute,
+		},
+		"create-null": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					testAttributeTypes,
+					map[string]attr.Value{
+						"create": types.StringNull(),
+					},
+				),
+			},
+			expectedTimeout: 20 * time.Minute,
+		},
+		"create-unknown": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					testAttributeTypes,
+					map[string]attr.Value{
+						"create": types.StringUnknown(),
+					},
+				),
+			},
+			expectedTimeout: 20 * time.Minute,
+		},
+		"create-missing": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{},
+					map[string]attr.Value{},
+				),
+			},
+			expectedTimeout: 20 * time.Minute,
+		},
+		"object-null": {
+			value: timeouts.Value{
+				Object: types.ObjectNull(testAttributeTypes),
+			},
+			expectedTimeout: 20 * time.Minute,
+		},
+		"create-invalid": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					testAttributeTypes,
+					map[string]attr.Value{
+						"create": types.StringValue("10x"),
+					},
+				),
+			},
+			expectedTimeout: 20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("timeouts").AtName("create"),
+					"Timeout Cannot Be Parsed",
+					`The create timeout value must be a string that can be parsed as a positive duration, such as "30s" or "2h45m". `+
+						`Valid time units are "ns" (nanoseconds), "us" or "µs" (microseconds), "ms" (milliseconds), "s" (seconds), "m" (minutes), "h" (hours).`+"\n\n"+
+						`Error: time: unknown unit "x" in duration "10x"`,
+				),
+			},
+		},
+		"create-milliseconds": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					testAttributeTypes,
+					map[string]attr.Value{
+						"create": types.StringValue("500ms"),
+					},
+				),
+			},
+			expectedTimeout: 500 * time.Millisecond,
+		},
+		"create-negative": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					testAttributeTypes,
+					map[string]attr.Value{
+						"create": types.StringValue("-5m"),
+					},
+				),
+			},
+			expectedTimeout: 20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("timeouts").AtName("create"),
+					"Timeout Cannot Be Parsed",
+					`The create timeout value must be a string that can be parsed as a positive duration, such as "30s" or "2h45m". `+
+						`Valid time units are "ns" (nanoseconds), "us" or "µs" (microseconds), "ms" (milliseconds), "s" (seconds), "m" (minutes), "h" (hours).`+"\n\n"+
+						`Error: duration "-5m" must be positive`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotTimeout, gotDiags := testCase.value.Create(context.Background(), 20*time.Minute)
+
+			if diff := cmp.Diff(gotTimeout, testCase.expectedTimeout); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueReadUpdateDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	value := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"read":   types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			},
+			map[string]attr.Value{
+				"read":   types.StringValue("1m"),
+				"update": types.StringValue("2m"),
+				"delete": types.StringValue("3m"),
+			},
+		),
+	}
+
+	readTimeout, diags := value.Read(ctx, time.Hour)
+
+	if diags.HasError() || readTimeout != time.Minute {
+		t.Errorf("unexpected read timeout: %s, diagnostics: %v", readTimeout, diags)
+	}
+
+	updateTimeout, diags := value.Update(ctx, time.Hour)
+
+	if diags.HasError() || updateTimeout != 2*time.Minute {
+		t.Errorf("unexpected update timeout: %s, diagnostics: %v", updateTimeout, diags)
+	}
+
+	deleteTimeout, diags := value.Delete(ctx, time.Hour)
+
+	if diags.HasError() || deleteTimeout != 3*time.Minute {
+		t.Errorf("unexpected delete timeout: %s, diagnostics: %v", deleteTimeout, diags)
+	}
+}
+
+func TestValueFromConfig(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		Timeouts timeouts.Value `tfsdk:"timeouts"`
+	}
+
+	ctx := context.Background()
+	config := tfsdk.Config{
+		Schema: schema.Schema{
+			Blocks: map[string]schema.Block{
+				"timeouts": timeouts.Block(ctx, timeouts.Opts{
+					Create: true,
+				}),
+			},
+		},
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"timeouts": tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"create": tftypes.String,
+						},
+					},
+				},
+			},
+			map[string]tftypes.Value{
+				"timeouts": tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"create": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"create": tftypes.NewValue(tftypes.String, "45s"),
+					},
+				),
+			},
+		),
+	}
+
+	var data testModel
+
+	diags := config.Get(ctx, &data)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	got, diags := data.Timeouts.Create(ctx, time.Minute)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got != 45*time.Second {
+		t.Errorf("expected 45s timeout, got: %s", got)
+	}
+}
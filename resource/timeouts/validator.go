@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// validTimeUnits describes the time units accepted by time.ParseDuration.
+const validTimeUnits = `Valid time units are "ns" (nanoseconds), "us" or "µs" (microseconds), "ms" (milliseconds), "s" (seconds), "m" (minutes), "h" (hours).`
+
+var _ validator.String = timeDurationValidator{}
+
+// timeDurationValidator ensures that a configured string value can be parsed
+// as a positive time.Duration. Null and unknown values are skipped.
+type timeDurationValidator struct{}
+
+// Description returns a plaintext description of the validator.
+func (v timeDurationValidator) Description(_ context.Context) string {
+	return `value must be a string that can be parsed as a positive duration, such as "30s" or "2h45m". ` + validTimeUnits
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v timeDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements the validation logic.
+func (v timeDurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseTimeout(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Time Duration",
+			fmt.Sprintf("Attribute %s %s\n\nError: %s", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+// parseTimeout returns the given string parsed as a time.Duration, returning
+// an error if the string cannot be parsed or the duration is not positive.
+func parseTimeout(value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if duration <= 0 {
+		return 0, fmt.Errorf("duration %q must be positive", value)
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBlockValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"valid": {
+			value: types.StringValue("2h45m"),
+		},
+		"valid-milliseconds": {
+			value: types.StringValue("500ms"),
+		},
+		"invalid": {
+			value: types.StringValue("10x"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("timeouts").AtName("create"),
+					"Invalid Attribute Value Time Duration",
+					`Attribute timeouts.create value must be a string that can be parsed as a positive duration, such as "30s" or "2h45m". `+
+						`Valid time units are "ns" (nanoseconds), "us" or "µs" (microseconds), "ms" (milliseconds), "s" (seconds), "m" (minutes), "h" (hours).`+"\n\n"+
+						`Error: time: unknown unit "x" in duration "10x"`,
+				),
+			},
+		},
+		"negative": {
+			value: types.StringValue("-5m"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("timeouts").AtName("create"),
+					"Invalid Attribute Value Time Duration",
+					`Attribute timeouts.create value must be a string that can be parsed as a positive duration, such as "30s" or "2h45m". `+
+						`Valid time units are "ns" (nanoseconds), "us" or "µs" (microseconds), "ms" (milliseconds), "s" (seconds), "m" (minutes), "h" (hours).`+"\n\n"+
+						`Error: duration "-5m" must be positive`,
+				),
+			},
+		},
+		"zero": {
+			value: types.StringValue("0s"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("timeouts").AtName("create"),
+					"Invalid Attribute Value Time Duration",
+					`Attribute timeouts.create value must be a string that can be parsed as a positive duration, such as "30s" or "2h45m". `+
+						`Valid time units are "ns" (nanoseconds), "us" or "µs" (microseconds), "ms" (milliseconds), "s" (seconds), "m" (minutes), "h" (hours).`+"\n\n"+
+						`Error: duration "0s" must be positive`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			block, ok := timeouts.Block(context.Background(), timeouts.Opts{Create: true}).(schema.SingleNestedBlock)
+
+			if !ok {
+				t.Fatal("expected schema.SingleNestedBlock")
+			}
+
+			attribute, ok := block.Attributes["create"].(schema.StringAttribute)
+
+			if !ok {
+				t.Fatal("expected schema.StringAttribute")
+			}
+
+			req := validator.StringRequest{
+				Path:        path.Root("timeouts").AtName("create"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			for _, v := range attribute.Validators {
+				v.ValidateString(context.Background(), req, resp)
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
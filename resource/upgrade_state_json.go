@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// UpgradeStateFromJSON is a helper for StateUpgrader implementations which
+// prefer to upgrade prior state data as generic JSON data, rather than
+// redeclaring the prior schema or working with tftypes values. The returned
+// DynamicValue is intended to be set as the UpgradeStateResponse type
+// DynamicValue field.
+//
+// The request RawState is first verified against the given prior schema
+// type, then decoded into a generic map of attribute names to values. JSON
+// numbers are decoded as json.Number to prevent precision loss. The transform
+// function receives that map and should return the data matching the current
+// schema. The result is encoded back as JSON, which the framework converts
+// into the current schema type, raising an error if it is not compatible.
+//
+//	StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+//		dynamicValue, diags := resource.UpgradeStateFromJSON(ctx, req, priorSchemaType, func(state map[string]interface{}) map[string]interface{} {
+//			state["new_name"] = state["old_name"]
+//			delete(state, "old_name")
+//
+//			return state
+//		})
+//
+//		resp.Diagnostics.Append(diags...)
+//		resp.DynamicValue = dynamicValue
+//	},
+func UpgradeStateFromJSON(ctx context.Context, req UpgradeStateRequest, priorSchemaType tftypes.Type, transform func(map[string]interface{}) map[string]interface{}) (*tfprotov6.DynamicValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if transform == nil {
+		diags.AddError(
+			"Missing State Upgrade Transform",
+			"An unexpected error was encountered when upgrading the resource state. "+
+				"The transform function passed to UpgradeStateFromJSON was missing. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return nil, diags
+	}
+
+	if req.RawState == nil {
+		diags.AddError(
+			"Missing Prior State Data",
+			"An unexpected error was encountered when upgrading the resource state. "+
+				"The prior state data was missing. "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+		)
+
+		return nil, diags
+	}
+
+	if priorSchemaType != nil {
+		_, err := req.RawState.UnmarshalWithOpts(priorSchemaType, tfprotov6.UnmarshalOpts{
+			ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+				IgnoreUndefinedAttributes: true,
+			},
+		})
+
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Prior State Data",
+				"An unexpected error was encountered when reading the prior resource state data using the prior schema type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return nil, diags
+		}
+	}
+
+	var priorState map[string]interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&priorState); err != nil {
+		diags.AddError(
+			"Unable to Decode Prior State Data",
+			"An unexpected error was encountered when decoding the prior resource state JSON data. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	upgradedState := transform(priorState)
+
+	upgradedJSON, err := json.Marshal(upgradedState)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Encode Upgraded State Data",
+			"An unexpected error was encountered when encoding the upgraded resource state JSON data. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return &tfprotov6.DynamicValue{
+		JSON: upgradedJSON,
+	}, diags
+}
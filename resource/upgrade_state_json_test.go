@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestUpgradeStateFromJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request         resource.UpgradeStateRequest
+		priorSchemaType tftypes.Type
+		transform       func(map[string]interface{}) map[string]interface{}
+		newSchemaType   tftypes.Type
+		expected        tftypes.Value
+		expectedDiags   diag.Diagnostics
+	}{
+		"rename-attribute": {
+			request: resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{
+					JSON: []byte(`{"id":"test-id","old_name":"test-value"}`),
+				},
+			},
+			priorSchemaType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":       tftypes.String,
+					"old_name": tftypes.String,
+				},
+			},
+			transform: func(state map[string]interface{}) map[string]interface{} {
+				state["new_name"] = state["old_name"]
+				delete(state, "old_name")
+
+				return state
+			},
+			newSchemaType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":       tftypes.String,
+					"new_name": tftypes.String,
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":       tftypes.String,
+						"new_name": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"id":       tftypes.NewValue(tftypes.String, "test-id"),
+					"new_name": tftypes.NewValue(tftypes.String, "test-value"),
+				},
+			),
+		},
+		"type-widening": {
+			request: resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{
+					JSON: []byte(`{"id":"test-id","port":8080}`),
+				},
+			},
+			priorSchemaType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":   tftypes.String,
+					"port": tftypes.Number,
+				},
+			},
+			transform: func(state map[string]interface{}) map[string]interface{} {
+				state["port"] = []interface{}{state["port"]}
+
+				return state
+			},
+			newSchemaType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":   tftypes.String,
+					"port": tftypes.List{ElementType: tftypes.Number},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":   tftypes.String,
+						"port": tftypes.List{ElementType: tftypes.Number},
+					},
+				},
+				map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, "test-id"),
+					"port": tftypes.NewValue(
+						tftypes.List{ElementType: tftypes.Number},
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.Number, 8080),
+						},
+					),
+				},
+			),
+		},
+		"prior-schema-type-mismatch": {
+			request: resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{
+					JSON: []byte(`{"id":"test-id","port":"not-a-number"}`),
+				},
+			},
+			priorSchemaType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":   tftypes.String,
+					"port": tftypes.Number,
+				},
+			},
+			transform: func(state map[string]interface{}) map[string]interface{} {
+				return state
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Read Prior State Data",
+					"An unexpected error was encountered when reading the prior resource state data using the prior schema type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Error: AttributeName(\"port\"): error parsing number: number has no digits",
+				),
+			},
+		},
+		"transform-missing": {
+			request: resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{
+					JSON: []byte(`{"id":"test-id"}`),
+				},
+			},
+			priorSchemaType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id": tftypes.String,
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing State Upgrade Transform",
+					"An unexpected error was encountered when upgrading the resource state. "+
+						"The transform function passed to UpgradeStateFromJSON was missing. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"raw-state-missing": {
+			request: resource.UpgradeStateRequest{},
+			transform: func(state map[string]interface{}) map[string]interface{} {
+				return state
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Prior State Data",
+					"An unexpected error was encountered when upgrading the resource state. "+
+						"The prior state data was missing. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := resource.UpgradeStateFromJSON(context.Background(), testCase.request, testCase.priorSchemaType, testCase.transform)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got == nil {
+				if testCase.newSchemaType != nil {
+					t.Fatal("expected DynamicValue, got none")
+				}
+
+				return
+			}
+
+			gotValue, err := got.Unmarshal(testCase.newSchemaType)
+
+			if err != nil {
+				t.Fatalf("unexpected error unmarshaling DynamicValue: %s", err)
+			}
+
+			if diff := cmp.Diff(gotValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
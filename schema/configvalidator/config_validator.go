@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configvalidator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ConfigValidator is a validator which can be used with any data source,
+// provider, or resource configuration.
+type ConfigValidator interface {
+	datasource.ConfigValidator
+	provider.ConfigValidator
+	resource.ConfigValidator
+}
@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package configvalidator provides validators which express relationships
+// between multiple attributes of a data source, provider, or resource
+// configuration. The validators implement the datasource.ConfigValidator,
+// provider.ConfigValidator, and resource.ConfigValidator interfaces.
+package configvalidator
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ConfigValidator = onlyOneTrueValidator{}
+
+// OnlyOneTrue returns a validator which ensures that at most one of the bool
+// attributes matching the given expressions is configured as true, such as
+// mutually exclusive feature flags. Null and false values are not counted.
+//
+// Unknown values are not counted either, as they may or may not become true.
+// An error is still returned if more than one known value is true, otherwise
+// validation is performed again once the values are known.
+func OnlyOneTrue(expressions ...path.Expression) ConfigValidator {
+	return onlyOneTrueValidator{
+		expressions: expressions,
+	}
+}
+
+// onlyOneTrueValidator implements the validator.
+type onlyOneTrueValidator struct {
+	expressions path.Expressions
+}
+
+// Description returns a plaintext description of the validator.
+func (v onlyOneTrueValidator) Description(_ context.Context) string {
+	expressions := make([]string, 0, len(v.expressions))
+
+	for _, expression := range v.expressions {
+		expressions = append(expressions, expression.String())
+	}
+
+	return "only one of these attributes can be true: " + strings.Join(expressions, ", ")
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v onlyOneTrueValidator) MarkdownDescription(_ context.Context) string {
+	expressions := make([]string, 0, len(v.expressions))
+
+	for _, expression := range v.expressions {
+		expressions = append(expressions, "`"+expression.String()+"`")
+	}
+
+	return "only one of these attributes can be true: " + strings.Join(expressions, ", ")
+}
+
+// ValidateDataSource implements the validation logic for data sources.
+func (v onlyOneTrueValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+// ValidateProvider implements the validation logic for providers.
+func (v onlyOneTrueValidator) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+// ValidateResource implements the validation logic for resources.
+func (v onlyOneTrueValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+// validate returns an error diagnostic for each true value after the first.
+func (v onlyOneTrueValidator) validate(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var matchedPaths path.Paths
+
+	for _, expression := range v.expressions {
+		expressionPaths, expressionDiags := config.PathMatches(ctx, expression)
+
+		diags.Append(expressionDiags...)
+
+		matchedPaths.Append(expressionPaths...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	var truePaths path.Paths
+
+	for _, matchedPath := range matchedPaths {
+		var value types.Bool
+
+		valueDiags := config.GetAttribute(ctx, matchedPath, &value)
+
+		diags.Append(valueDiags...)
+
+		if valueDiags.HasError() || !value.ValueBool() {
+			continue
+		}
+
+		truePaths = append(truePaths, matchedPath)
+	}
+
+	if len(truePaths) < 2 {
+		return diags
+	}
+
+	for _, truePath := range truePaths[1:] {
+		diags.AddAttributeError(
+			truePath,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %s cannot be true when %s is true, as %s", truePath, truePaths[0], v.Description(ctx)),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/configvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOnlyOneTrueValidator(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"flag_a": testschema.Attribute{
+				Optional: true,
+				Type:     types.BoolType,
+			},
+			"flag_b": testschema.Attribute{
+				Optional: true,
+				Type:     types.BoolType,
+			},
+			"flag_c": testschema.Attribute{
+				Optional: true,
+				Type:     types.BoolType,
+			},
+		},
+	}
+
+	testConfig := func(flagA, flagB, flagC interface{}) tfsdk.Config {
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"flag_a": tftypes.Bool,
+						"flag_b": tftypes.Bool,
+						"flag_c": tftypes.Bool,
+					},
+				},
+				map[string]tftypes.Value{
+					"flag_a": tftypes.NewValue(tftypes.Bool, flagA),
+					"flag_b": tftypes.NewValue(tftypes.Bool, flagB),
+					"flag_c": tftypes.NewValue(tftypes.Bool, flagC),
+				},
+			),
+			Schema: testSchema,
+		}
+	}
+
+	testExpressions := []path.Expression{
+		path.MatchRoot("flag_a"),
+		path.MatchRoot("flag_b"),
+		path.MatchRoot("flag_c"),
+	}
+
+	testCases := map[string]struct {
+		expressions []path.Expression
+		config      tfsdk.Config
+		expected    diag.Diagnostics
+	}{
+		"zero-true": {
+			expressions: testExpressions,
+			config:      testConfig(false, nil, false),
+		},
+		"one-true": {
+			expressions: testExpressions,
+			config:      testConfig(false, true, nil),
+		},
+		"two-true": {
+			expressions: testExpressions,
+			config:      testConfig(true, nil, true),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("flag_c"),
+					"Invalid Attribute Combination",
+					"Attribute flag_c cannot be true when flag_a is true, as only one of these attributes can be true: flag_a, flag_b, flag_c",
+				),
+			},
+		},
+		"three-true": {
+			expressions: testExpressions,
+			config:      testConfig(true, true, true),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("flag_b"),
+					"Invalid Attribute Combination",
+					"Attribute flag_b cannot be true when flag_a is true, as only one of these attributes can be true: flag_a, flag_b, flag_c",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("flag_c"),
+					"Invalid Attribute Combination",
+					"Attribute flag_c cannot be true when flag_a is true, as only one of these attributes can be true: flag_a, flag_b, flag_c",
+				),
+			},
+		},
+		"one-true-unknown": {
+			expressions: testExpressions,
+			config:      testConfig(true, tftypes.UnknownValue, false),
+		},
+		"two-true-unknown": {
+			expressions: testExpressions,
+			config:      testConfig(true, tftypes.UnknownValue, true),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("flag_c"),
+					"Invalid Attribute Combination",
+					"Attribute flag_c cannot be true when flag_a is true, as only one of these attributes can be true: flag_a, flag_b, flag_c",
+				),
+			},
+		},
+		"duplicate-expressions": {
+			expressions: []path.Expression{
+				path.MatchRoot("flag_a"),
+				path.MatchRoot("flag_a"),
+			},
+			config: testConfig(true, true, true),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := configvalidator.OnlyOneTrue(testCase.expressions...)
+
+			dataSourceResp := &datasource.ValidateConfigResponse{}
+			validator.ValidateDataSource(context.Background(), datasource.ValidateConfigRequest{Config: testCase.config}, dataSourceResp)
+
+			if diff := cmp.Diff(dataSourceResp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected data source difference: %s", diff)
+			}
+
+			providerResp := &provider.ValidateConfigResponse{}
+			validator.ValidateProvider(context.Background(), provider.ValidateConfigRequest{Config: testCase.config}, providerResp)
+
+			if diff := cmp.Diff(providerResp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected provider difference: %s", diff)
+			}
+
+			resourceResp := &resource.ValidateConfigResponse{}
+			validator.ValidateResource(context.Background(), resource.ValidateConfigRequest{Config: testCase.config}, resourceResp)
+
+			if diff := cmp.Diff(resourceResp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected resource difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Float64IsFinite returns a validator which ensures that any configured
+// float64 value is finite, rejecting NaN and positive or negative infinity.
+// Null and unknown values are skipped.
+func Float64IsFinite() validator.Float64 {
+	return isFiniteValidator{}
+}
+
+// isFiniteValidator implements the validator.
+type isFiniteValidator struct{}
+
+// Description returns a plaintext description of the validator.
+func (v isFiniteValidator) Description(_ context.Context) string {
+	return "value must be a finite number"
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v isFiniteValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 implements the validation logic.
+func (v isFiniteValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if !math.IsNaN(value) && !math.IsInf(value, 0) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %f", req.Path, v.Description(ctx), value),
+	)
+}
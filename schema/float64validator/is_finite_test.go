@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64validator_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFloat64IsFiniteValidatorValidateFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.Float64Request
+		expected *validator.Float64Response
+	}{
+		"null": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Null(),
+			},
+			expected: &validator.Float64Response{},
+		},
+		"unknown": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Unknown(),
+			},
+			expected: &validator.Float64Response{},
+		},
+		"finite": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(1.5),
+			},
+			expected: &validator.Float64Response{},
+		},
+		"nan": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(math.NaN()),
+			},
+			expected: &validator.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be a finite number, got: NaN",
+					),
+				},
+			},
+		},
+		"positive-infinity": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(math.Inf(1)),
+			},
+			expected: &validator.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be a finite number, got: +Inf",
+					),
+				},
+			},
+		},
+		"negative-infinity": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(math.Inf(-1)),
+			},
+			expected: &validator.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be a finite number, got: -Inf",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.Float64Response{}
+
+			float64validator.Float64IsFinite().ValidateFloat64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Int64MultipleOf returns a validator which ensures that any configured
+// int64 value is a multiple of the given divisor, such as page sizes which
+// must align to a boundary. Null and unknown values are skipped. A zero
+// divisor is a provider error and returns an error diagnostic for any value.
+func Int64MultipleOf(divisor int64) validator.Int64 {
+	return multipleOfValidator{
+		divisor: divisor,
+	}
+}
+
+// multipleOfValidator implements the validator.
+type multipleOfValidator struct {
+	divisor int64
+}
+
+// Description returns a plaintext description of the validator.
+func (v multipleOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a multiple of %d", v.divisor)
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v multipleOfValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be a multiple of `%d`", v.divisor)
+}
+
+// ValidateInt64 implements the validation logic.
+func (v multipleOfValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if v.divisor == 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Validator Configuration",
+			"While validating that the value is a multiple of a divisor, the divisor was zero. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if value%v.divisor != 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value),
+		)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// SameSizeAs returns a validator which ensures that any configured list
+// contains the same number of elements as the list, set, or map found at
+// each path matching the given expression. Relative expressions are resolved
+// against the path of the attribute being validated. Null and unknown values,
+// on either side, are skipped.
+func SameSizeAs(expression path.Expression) validator.List {
+	return sameSizeAsValidator{
+		expression: expression,
+	}
+}
+
+// sameSizeAsValidator implements the validator.
+type sameSizeAsValidator struct {
+	expression path.Expression
+}
+
+// Description returns a plaintext description of the validator.
+func (v sameSizeAsValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("list must contain the same number of elements as %s", v.expression)
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v sameSizeAsValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("list must contain the same number of elements as `%s`", v.expression)
+}
+
+// ValidateList implements the validation logic.
+func (v sameSizeAsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	expression := req.PathExpression.Merge(v.expression)
+
+	matchedPaths, diags := req.Config.PathMatches(ctx, expression)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	size := len(req.ConfigValue.Elements())
+
+	for _, matchedPath := range matchedPaths {
+		// Do not compare the attribute against itself.
+		if matchedPath.Equal(req.Path) {
+			continue
+		}
+
+		var matchedValue attr.Value
+
+		diags := req.Config.GetAttribute(ctx, matchedPath, &matchedValue)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		if matchedValue.IsNull() || matchedValue.IsUnknown() {
+			continue
+		}
+
+		matchedSize, diags := collectionSize(ctx, matchedPath, matchedValue)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		if size != matchedSize {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Attribute Value Size",
+				fmt.Sprintf("Attribute %s must contain the same number of elements as %s (%d), got: %d", req.Path, matchedPath, matchedSize, size),
+			)
+		}
+	}
+}
+
+// collectionSize returns the number of elements in a list, set, or map value.
+func collectionSize(ctx context.Context, p path.Path, value attr.Value) (int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch value := value.(type) {
+	case basetypes.ListValuable:
+		listValue, listDiags := value.ToListValue(ctx)
+
+		diags.Append(listDiags...)
+
+		return len(listValue.Elements()), diags
+	case basetypes.SetValuable:
+		setValue, setDiags := value.ToSetValue(ctx)
+
+		diags.Append(setDiags...)
+
+		return len(setValue.Elements()), diags
+	case basetypes.MapValuable:
+		mapValue, mapDiags := value.ToMapValue(ctx)
+
+		diags.Append(mapDiags...)
+
+		return len(mapValue.Elements()), diags
+	default:
+		diags.AddAttributeError(
+			p,
+			"Invalid Attribute Value Type",
+			"While validating a list size, the referenced attribute was not a list, set, or map. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Path: %s\n", p)+
+				fmt.Sprintf("Value Type: %T", value),
+		)
+
+		return 0, diags
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSameSizeAsValidatorValidateList(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+			"other_list": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+			"other_set": testschema.Attribute{
+				Optional: true,
+				Type:     types.SetType{ElemType: types.StringType},
+			},
+		},
+	}
+
+	testConfig := func(test, otherList, otherSet tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test":       tftypes.List{ElementType: tftypes.String},
+						"other_list": tftypes.List{ElementType: tftypes.String},
+						"other_set":  tftypes.Set{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"test":       test,
+					"other_list": otherList,
+					"other_set":  otherSet,
+				},
+			),
+			Schema: testSchema,
+		}
+	}
+
+	tfList := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+
+		for _, value := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.String, value))
+		}
+
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	}
+
+	tfSet := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+
+		for _, value := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.String, value))
+		}
+
+		return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elements)
+	}
+
+	listValue := func(values ...string) types.List {
+		elements := make([]attr.Value, 0, len(values))
+
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+
+		return types.ListValueMust(types.StringType, elements)
+	}
+
+	testCases := map[string]struct {
+		expression path.Expression
+		request    validator.ListRequest
+		expected   *validator.ListResponse
+	}{
+		"null": {
+			expression: path.MatchRoot("other_list"),
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				Config:         testConfig(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil), tfList("a"), tfSet("a")),
+				ConfigValue:    types.ListNull(types.StringType),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"unknown": {
+			expression: path.MatchRoot("other_list"),
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				Config:         testConfig(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue), tfList("a"), tfSet("a")),
+				ConfigValue:    types.ListUnknown(types.StringType),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"other-null": {
+			expression: path.MatchRoot("other_list"),
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				Config:         testConfig(tfList("a", "b"), tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil), tfSet("a")),
+				ConfigValue:    listValue("a", "b"),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"other-unknown": {
+			expression: path.MatchRoot("other_list"),
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				Config:         testConfig(tfList("a", "b"), tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue), tfSet("a")),
+				ConfigValue:    listValue("a", "b"),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"matching-list": {
+			expression: path.MatchRoot("other_list"),
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				Config:         testConfig(tfList("a", "b"), tfList("c", "d"), tfSet("a")),
+				ConfigValue:    listValue("a", "b"),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"matching-set-relative": {
+			expression: path.MatchRelative().AtParent().AtName("other_set"),
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				Config:         testConfig(tfList("a", "b"), tfList("c"), tfSet("c", "d")),
+				ConfigValue:    listValue("a", "b"),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"mismatching-list": {
+			expression: path.MatchRoot("other_list"),
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				Config:         testConfig(tfList("a", "b"), tfList("c"), tfSet("a")),
+				ConfigValue:    listValue("a", "b"),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value Size",
+						"Attribute test must contain the same number of elements as other_list (1), got: 2",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.ListResponse{}
+
+			listvalidator.SameSizeAs(testCase.expression).ValidateList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
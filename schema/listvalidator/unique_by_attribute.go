@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// UniqueByAttribute returns a validator which ensures that no two object
+// elements of any configured list have the same value for the given object
+// attribute name, such as a unique name within a list of nested attributes
+// or blocks. Null and unknown values, including null or unknown elements and
+// element attribute values, are skipped.
+func UniqueByAttribute(attrName string) validator.List {
+	return uniqueByAttributeValidator{
+		attrName: attrName,
+	}
+}
+
+// uniqueValue is an attribute value and the index of the first list element
+// containing it.
+type uniqueValue struct {
+	index int
+	value attr.Value
+}
+
+// uniqueByAttributeValidator implements the validator.
+type uniqueByAttributeValidator struct {
+	attrName string
+}
+
+// Description returns a plaintext description of the validator.
+func (v uniqueByAttributeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("list elements must have unique %q attribute values", v.attrName)
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v uniqueByAttributeValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("list elements must have unique `%s` attribute values", v.attrName)
+}
+
+// ValidateList implements the validation logic.
+func (v uniqueByAttributeValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// uniqueValues contains the first element index of each attribute value.
+	var uniqueValues []uniqueValue
+
+	for index, element := range req.ConfigValue.Elements() {
+		elementPath := req.Path.AtListIndex(index)
+
+		objectValuable, ok := element.(basetypes.ObjectValuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				elementPath,
+				"Invalid Attribute Value Type",
+				"While validating unique list element attribute values, the list element was not an object. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Path: %s\n", elementPath)+
+					fmt.Sprintf("Value Type: %T", element),
+			)
+
+			return
+		}
+
+		if objectValuable.IsNull() || objectValuable.IsUnknown() {
+			continue
+		}
+
+		objectValue, diags := objectValuable.ToObjectValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		attributePath := elementPath.AtName(v.attrName)
+		value, ok := objectValue.Attributes()[v.attrName]
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				attributePath,
+				"Invalid Attribute Name",
+				"While validating unique list element attribute values, the list element object did not contain the attribute. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Path: %s", attributePath),
+			)
+
+			return
+		}
+
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		duplicate := false
+
+		for _, unique := range uniqueValues {
+			if !unique.value.Equal(value) {
+				continue
+			}
+
+			resp.Diagnostics.AddAttributeError(
+				attributePath,
+				"Duplicate Attribute Value",
+				fmt.Sprintf("Attribute %s value %s must be unique, but is the same as %s", attributePath, value, req.Path.AtListIndex(unique.index).AtName(v.attrName)),
+			)
+
+			duplicate = true
+
+			break
+		}
+
+		if !duplicate {
+			uniqueValues = append(uniqueValues, uniqueValue{index: index, value: value})
+		}
+	}
+}
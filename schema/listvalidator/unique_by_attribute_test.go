@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUniqueByAttributeValidatorValidateList(t *testing.T) {
+	t.Parallel()
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  types.StringType,
+			"value": types.StringType,
+		},
+	}
+
+	object := func(name, value types.String) attr.Value {
+		return types.ObjectValueMust(
+			objectType.AttrTypes,
+			map[string]attr.Value{
+				"name":  name,
+				"value": value,
+			},
+		)
+	}
+
+	list := func(elements ...attr.Value) types.List {
+		return types.ListValueMust(objectType, elements)
+	}
+
+	testCases := map[string]struct {
+		attrName string
+		request  validator.ListRequest
+		expected *validator.ListResponse
+	}{
+		"null": {
+			attrName: "name",
+			request: validator.ListRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.ListNull(objectType),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"unknown": {
+			attrName: "name",
+			request: validator.ListRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.ListUnknown(objectType),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"unique": {
+			attrName: "name",
+			request: validator.ListRequest{
+				Path: path.Root("test"),
+				ConfigValue: list(
+					object(types.StringValue("first"), types.StringValue("same")),
+					object(types.StringValue("second"), types.StringValue("same")),
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"unique-null-unknown": {
+			attrName: "name",
+			request: validator.ListRequest{
+				Path: path.Root("test"),
+				ConfigValue: list(
+					object(types.StringNull(), types.StringValue("one")),
+					object(types.StringNull(), types.StringValue("two")),
+					object(types.StringUnknown(), types.StringValue("three")),
+					object(types.StringUnknown(), types.StringValue("four")),
+					types.ObjectNull(objectType.AttrTypes),
+					types.ObjectUnknown(objectType.AttrTypes),
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"duplicate": {
+			attrName: "name",
+			request: validator.ListRequest{
+				Path: path.Root("test"),
+				ConfigValue: list(
+					object(types.StringValue("first"), types.StringValue("one")),
+					object(types.StringValue("second"), types.StringValue("two")),
+					object(types.StringValue("first"), types.StringValue("three")),
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(2).AtName("name"),
+						"Duplicate Attribute Value",
+						`Attribute test[2].name value "first" must be unique, but is the same as test[0].name`,
+					),
+				},
+			},
+		},
+		"duplicate-multiple": {
+			attrName: "name",
+			request: validator.ListRequest{
+				Path: path.Root("test"),
+				ConfigValue: list(
+					object(types.StringValue("first"), types.StringValue("one")),
+					object(types.StringValue("first"), types.StringValue("two")),
+					object(types.StringValue("first"), types.StringValue("three")),
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(1).AtName("name"),
+						"Duplicate Attribute Value",
+						`Attribute test[1].name value "first" must be unique, but is the same as test[0].name`,
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(2).AtName("name"),
+						"Duplicate Attribute Value",
+						`Attribute test[2].name value "first" must be unique, but is the same as test[0].name`,
+					),
+				},
+			},
+		},
+		"missing-attribute": {
+			attrName: "other",
+			request: validator.ListRequest{
+				Path: path.Root("test"),
+				ConfigValue: list(
+					object(types.StringValue("first"), types.StringValue("one")),
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(0).AtName("other"),
+						"Invalid Attribute Name",
+						"While validating unique list element attribute values, the list element object did not contain the attribute. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Path: test[0].other",
+					),
+				},
+			},
+		},
+		"non-object-elements": {
+			attrName: "name",
+			request: validator.ListRequest{
+				Path: path.Root("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{types.StringValue("first")},
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(0),
+						"Invalid Attribute Value Type",
+						"While validating unique list element attribute values, the list element was not an object. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Path: test[0]\n"+
+							"Value Type: basetypes.StringValue",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.ListResponse{}
+
+			listvalidator.UniqueByAttribute(testCase.attrName).ValidateList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// MapHasKeys returns a validator which ensures that any configured map
+// contains all of the given keys. Null and unknown values are skipped.
+func MapHasKeys(keys ...string) validator.Map {
+	return hasKeysValidator{
+		keys: keys,
+	}
+}
+
+// hasKeysValidator implements the validator.
+type hasKeysValidator struct {
+	keys []string
+}
+
+// Description returns a plaintext description of the validator.
+func (v hasKeysValidator) Description(_ context.Context) string {
+	keys := make([]string, 0, len(v.keys))
+
+	for _, key := range v.keys {
+		keys = append(keys, fmt.Sprintf("%q", key))
+	}
+
+	return "map must contain all of the keys: " + strings.Join(keys, ", ")
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v hasKeysValidator) MarkdownDescription(_ context.Context) string {
+	keys := make([]string, 0, len(v.keys))
+
+	for _, key := range v.keys {
+		keys = append(keys, "`"+key+"`")
+	}
+
+	return "map must contain all of the keys: " + strings.Join(keys, ", ")
+}
+
+// ValidateMap implements the validation logic.
+func (v hasKeysValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+
+	for _, key := range v.keys {
+		if _, ok := elements[key]; ok {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Missing Attribute Value Key",
+			fmt.Sprintf("Attribute %s must contain the key %q", req.Path, key),
+		)
+	}
+}
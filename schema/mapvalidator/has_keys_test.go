@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMapHasKeysValidatorValidateMap(t *testing.T) {
+	t.Parallel()
+
+	testKeys := []string{"first", "second"}
+
+	testCases := map[string]struct {
+		keys     []string
+		request  validator.MapRequest
+		expected *validator.MapResponse
+	}{
+		"null": {
+			keys: testKeys,
+			request: validator.MapRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.MapNull(types.StringType),
+			},
+			expected: &validator.MapResponse{},
+		},
+		"unknown": {
+			keys: testKeys,
+			request: validator.MapRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.MapUnknown(types.StringType),
+			},
+			expected: &validator.MapResponse{},
+		},
+		"keys-present": {
+			keys: testKeys,
+			request: validator.MapRequest{
+				Path: path.Root("test"),
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"first":  types.StringValue("one"),
+						"second": types.StringValue("two"),
+						"third":  types.StringValue("three"),
+					},
+				),
+			},
+			expected: &validator.MapResponse{},
+		},
+		"keys-missing": {
+			keys: testKeys,
+			request: validator.MapRequest{
+				Path: path.Root("test"),
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"third": types.StringValue("three"),
+					},
+				),
+			},
+			expected: &validator.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Missing Attribute Value Key",
+						`Attribute test must contain the key "first"`,
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Missing Attribute Value Key",
+						`Attribute test must contain the key "second"`,
+					),
+				},
+			},
+		},
+		"key-partially-missing": {
+			keys: testKeys,
+			request: validator.MapRequest{
+				Path: path.Root("test"),
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"first": types.StringValue("one"),
+					},
+				),
+			},
+			expected: &validator.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Missing Attribute Value Key",
+						`Attribute test must contain the key "second"`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.MapResponse{}
+
+			mapvalidator.MapHasKeys(testCase.keys...).ValidateMap(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// IsWholeNumber returns a validator which ensures that any configured number
+// value has no fractional component, such as when the value must be sent to
+// an API as an integer. Null and unknown values are skipped.
+func IsWholeNumber() validator.Number {
+	return isWholeNumberValidator{}
+}
+
+// isWholeNumberValidator implements the validator.
+type isWholeNumberValidator struct{}
+
+// Description returns a plaintext description of the validator.
+func (v isWholeNumberValidator) Description(_ context.Context) string {
+	return "value must be a whole number"
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v isWholeNumberValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber implements the validation logic.
+func (v isWholeNumberValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if value == nil || value.IsInt() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value.Text('f', -1)),
+	)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsWholeNumberValidatorValidateNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.NumberRequest
+		expected *validator.NumberResponse
+	}{
+		"null": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberNull(),
+			},
+			expected: &validator.NumberResponse{},
+		},
+		"unknown": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberUnknown(),
+			},
+			expected: &validator.NumberResponse{},
+		},
+		"whole": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(3.0)),
+			},
+			expected: &validator.NumberResponse{},
+		},
+		"whole-negative": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(-42)),
+			},
+			expected: &validator.NumberResponse{},
+		},
+		"whole-zero": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(0)),
+			},
+			expected: &validator.NumberResponse{},
+		},
+		"fractional": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(3.5)),
+			},
+			expected: &validator.NumberResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be a whole number, got: 3.5",
+					),
+				},
+			},
+		},
+		"fractional-negative": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(-0.25)),
+			},
+			expected: &validator.NumberResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be a whole number, got: -0.25",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.NumberResponse{}
+
+			numbervalidator.IsWholeNumber().ValidateNumber(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
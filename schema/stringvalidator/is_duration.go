@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// IsDuration returns a validator which ensures that any configured string
+// value is a valid duration, as parsed by time.ParseDuration, such as "30s"
+// or "1h15m". Null and unknown values are skipped.
+func IsDuration() validator.String {
+	return isDurationValidator{}
+}
+
+// isDurationValidator implements the validator.
+type isDurationValidator struct{}
+
+// Description returns a plaintext description of the validator.
+func (v isDurationValidator) Description(_ context.Context) string {
+	return `value must be a valid duration, such as "30s" or "1h15m"`
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v isDurationValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a valid duration, such as `30s` or `1h15m`"
+}
+
+// ValidateString implements the validation logic.
+func (v isDurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if _, err := time.ParseDuration(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s\n\nError: %s", req.Path, v.Description(ctx), value, err),
+		)
+	}
+}
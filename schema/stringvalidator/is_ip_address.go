@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ipAddressVersion is the IP address version accepted by an IP address
+// validator.
+type ipAddressVersion int
+
+const (
+	ipAddressVersionAny ipAddressVersion = iota
+	ipAddressVersionV4
+	ipAddressVersionV6
+)
+
+// IsCIDR returns a validator which ensures that any configured string value
+// is a valid IPv4 or IPv6 CIDR block, as parsed by net.ParseCIDR, such as
+// "10.0.0.0/16" or "2001:db8::/32". Null and unknown values are skipped.
+func IsCIDR() validator.String {
+	return isCIDRValidator{}
+}
+
+// IsIPAddress returns a validator which ensures that any configured string
+// value is a valid IPv4 or IPv6 address, as parsed by net.ParseIP, such as
+// "10.0.0.1" or "2001:db8::1". Null and unknown values are skipped.
+func IsIPAddress() validator.String {
+	return isIPAddressValidator{
+		version: ipAddressVersionAny,
+	}
+}
+
+// IsIPv4Address returns a validator which ensures that any configured string
+// value is a valid IPv4 address in dotted decimal form, such as "10.0.0.1".
+// IPv6 addresses, including IPv4-mapped IPv6 addresses such as
+// "::ffff:10.0.0.1", are not valid. Null and unknown values are skipped.
+func IsIPv4Address() validator.String {
+	return isIPAddressValidator{
+		version: ipAddressVersionV4,
+	}
+}
+
+// IsIPv6Address returns a validator which ensures that any configured string
+// value is a valid IPv6 address, such as "2001:db8::1". IPv4 addresses are
+// not valid. Null and unknown values are skipped.
+func IsIPv6Address() validator.String {
+	return isIPAddressValidator{
+		version: ipAddressVersionV6,
+	}
+}
+
+// isCIDRValidator implements the validator.
+type isCIDRValidator struct{}
+
+// Description returns a plaintext description of the validator.
+func (v isCIDRValidator) Description(_ context.Context) string {
+	return `value must be a valid CIDR block, such as "10.0.0.0/16"`
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v isCIDRValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a valid CIDR block, such as `10.0.0.0/16`"
+}
+
+// ValidateString implements the validation logic.
+func (v isCIDRValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s\n\nError: %s", req.Path, v.Description(ctx), value, err),
+		)
+	}
+}
+
+// isIPAddressValidator implements the validator.
+type isIPAddressValidator struct {
+	version ipAddressVersion
+}
+
+// Description returns a plaintext description of the validator.
+func (v isIPAddressValidator) Description(_ context.Context) string {
+	switch v.version {
+	case ipAddressVersionV4:
+		return `value must be a valid IPv4 address, such as "10.0.0.1"`
+	case ipAddressVersionV6:
+		return `value must be a valid IPv6 address, such as "2001:db8::1"`
+	default:
+		return `value must be a valid IP address, such as "10.0.0.1" or "2001:db8::1"`
+	}
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v isIPAddressValidator) MarkdownDescription(_ context.Context) string {
+	switch v.version {
+	case ipAddressVersionV4:
+		return "value must be a valid IPv4 address, such as `10.0.0.1`"
+	case ipAddressVersionV6:
+		return "value must be a valid IPv6 address, such as `2001:db8::1`"
+	default:
+		return "value must be a valid IP address, such as `10.0.0.1` or `2001:db8::1`"
+	}
+}
+
+// ValidateString implements the validation logic.
+func (v isIPAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	ip := net.ParseIP(value)
+
+	// net.ParseIP accepts both versions, where IPv6 addresses always contain
+	// a colon, including IPv4-mapped IPv6 addresses.
+	isIPv6 := strings.Contains(value, ":")
+
+	valid := ip != nil
+
+	switch v.version {
+	case ipAddressVersionV4:
+		valid = valid && !isIPv6
+	case ipAddressVersionV6:
+		valid = valid && isIPv6
+	}
+
+	if !valid {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+		)
+	}
+}
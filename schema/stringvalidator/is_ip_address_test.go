@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsCIDRValidatorValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.StringRequest
+		expected *validator.StringResponse
+	}{
+		"null": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"valid-ipv4": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("10.0.0.0/16"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"valid-ipv6": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("2001:db8::/32"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"invalid-missing-prefix": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("10.0.0.0"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be a valid CIDR block, such as "10.0.0.0/16", got: 10.0.0.0`+"\n\n"+
+							"Error: invalid CIDR address: 10.0.0.0",
+					),
+				},
+			},
+		},
+		"invalid-prefix-length": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("10.0.0.0/33"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be a valid CIDR block, such as "10.0.0.0/16", got: 10.0.0.0/33`+"\n\n"+
+							"Error: invalid CIDR address: 10.0.0.0/33",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			stringvalidator.IsCIDR().ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIsIPAddressValidatorValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator validator.String
+		request   validator.StringRequest
+		expected  *validator.StringResponse
+	}{
+		"any-null": {
+			validator: stringvalidator.IsIPAddress(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"any-unknown": {
+			validator: stringvalidator.IsIPAddress(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"any-valid-ipv4": {
+			validator: stringvalidator.IsIPAddress(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("10.0.0.1"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"any-valid-ipv6": {
+			validator: stringvalidator.IsIPAddress(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("2001:db8::1"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"any-invalid": {
+			validator: stringvalidator.IsIPAddress(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("10.0.0.256"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be a valid IP address, such as "10.0.0.1" or "2001:db8::1", got: 10.0.0.256`,
+					),
+				},
+			},
+		},
+		"ipv4-valid": {
+			validator: stringvalidator.IsIPv4Address(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("192.168.1.1"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"ipv4-invalid-ipv6": {
+			validator: stringvalidator.IsIPv4Address(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("2001:db8::1"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be a valid IPv4 address, such as "10.0.0.1", got: 2001:db8::1`,
+					),
+				},
+			},
+		},
+		"ipv4-invalid-ipv4-mapped-ipv6": {
+			validator: stringvalidator.IsIPv4Address(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("::ffff:10.0.0.1"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be a valid IPv4 address, such as "10.0.0.1", got: ::ffff:10.0.0.1`,
+					),
+				},
+			},
+		},
+		"ipv4-invalid-garbage": {
+			validator: stringvalidator.IsIPv4Address(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("not-an-ip"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be a valid IPv4 address, such as "10.0.0.1", got: not-an-ip`,
+					),
+				},
+			},
+		},
+		"ipv6-valid": {
+			validator: stringvalidator.IsIPv6Address(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("fe80::1"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"ipv6-invalid-ipv4": {
+			validator: stringvalidator.IsIPv6Address(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("10.0.0.1"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be a valid IPv6 address, such as "2001:db8::1", got: 10.0.0.1`,
+					),
+				},
+			},
+		},
+		"ipv6-invalid-garbage": {
+			validator: stringvalidator.IsIPv6Address(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("2001:db8::zz"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be a valid IPv6 address, such as "2001:db8::1", got: 2001:db8::zz`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			testCase.validator.ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
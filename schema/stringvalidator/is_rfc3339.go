@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// IsRFC3339 returns a validator which ensures that any configured string
+// value is a valid RFC3339 timestamp, as parsed by time.Parse with the
+// time.RFC3339 layout, such as "2006-01-02T15:04:05Z". Date-only values are
+// not valid. Null and unknown values are skipped.
+func IsRFC3339() validator.String {
+	return isTimestampValidator{
+		example: "2006-01-02T15:04:05Z",
+		layout:  time.RFC3339,
+		name:    "RFC3339",
+	}
+}
+
+// IsRFC3339Nano returns a validator which ensures that any configured string
+// value is a valid RFC3339 timestamp, as parsed by time.Parse with the
+// time.RFC3339Nano layout, such as "2006-01-02T15:04:05.999999999Z". The
+// fractional seconds are optional. Null and unknown values are skipped.
+func IsRFC3339Nano() validator.String {
+	return isTimestampValidator{
+		example: "2006-01-02T15:04:05.999999999Z",
+		layout:  time.RFC3339Nano,
+		name:    "RFC3339Nano",
+	}
+}
+
+// isTimestampValidator implements the validator.
+type isTimestampValidator struct {
+	example string
+	layout  string
+	name    string
+}
+
+// Description returns a plaintext description of the validator.
+func (v isTimestampValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a valid %s timestamp, such as %q", v.name, v.example)
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v isTimestampValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value must be a valid %s timestamp, such as `%s`", v.name, v.example)
+}
+
+// ValidateString implements the validation logic.
+func (v isTimestampValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if _, err := time.Parse(v.layout, value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s\n\nError: %s", req.Path, v.Description(ctx), value, err),
+		)
+	}
+}
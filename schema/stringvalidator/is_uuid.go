@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// uuidRegex matches the RFC 4122 string representation of a UUID, either
+// with or without hyphens, in any letter case.
+var uuidRegex = regexp.MustCompile(`^(?i:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|[0-9a-f]{32})$`)
+
+// IsUUID returns a validator which ensures that any configured string value
+// is a valid RFC 4122 UUID, such as "6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+// The hyphens are optional and hexadecimal digits are case-insensitive. Null
+// and unknown values are skipped.
+func IsUUID() validator.String {
+	return isUUIDValidator{}
+}
+
+// IsUUIDVersion returns a validator which ensures that any configured string
+// value is a valid RFC 4122 UUID of the given version, such as 4 for randomly
+// generated UUIDs. The hyphens are optional and hexadecimal digits are
+// case-insensitive. Null and unknown values are skipped.
+func IsUUIDVersion(version int) validator.String {
+	return isUUIDValidator{
+		version: version,
+	}
+}
+
+// isUUIDValidator implements the validator.
+type isUUIDValidator struct {
+	// version is the required UUID version. Zero allows any version.
+	version int
+}
+
+// Description returns a plaintext description of the validator.
+func (v isUUIDValidator) Description(_ context.Context) string {
+	if v.version != 0 {
+		return fmt.Sprintf(`value must be a valid version %d UUID, such as "xxxxxxxx-xxxx-%dxxx-xxxx-xxxxxxxxxxxx"`, v.version, v.version)
+	}
+
+	return `value must be a valid UUID, such as "6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v isUUIDValidator) MarkdownDescription(_ context.Context) string {
+	if v.version != 0 {
+		return fmt.Sprintf("value must be a valid version %d UUID, such as `xxxxxxxx-xxxx-%dxxx-xxxx-xxxxxxxxxxxx`", v.version, v.version)
+	}
+
+	return "value must be a valid UUID, such as `6ba7b810-9dad-11d1-80b4-00c04fd430c8`"
+}
+
+// ValidateString implements the validation logic.
+func (v isUUIDValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if !uuidRegex.MatchString(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+		)
+
+		return
+	}
+
+	if v.version == 0 {
+		return
+	}
+
+	// The version is the first hexadecimal digit of the third group.
+	version, _ := strconv.ParseInt(strings.ReplaceAll(value, "-", "")[12:13], 16, 0)
+
+	if int(version) != v.version {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got version %d UUID: %s", req.Path, v.Description(ctx), version, value),
+		)
+	}
+}
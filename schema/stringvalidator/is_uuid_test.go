@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsUUIDValidatorValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator validator.String
+		request   validator.StringRequest
+		expected  *validator.StringResponse
+	}{
+		"null": {
+			validator: stringvalidator.IsUUID(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown": {
+			validator: stringvalidator.IsUUID(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"valid-v4": {
+			validator: stringvalidator.IsUUID(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"valid-uppercase": {
+			validator: stringvalidator.IsUUID(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("F47AC10B-58CC-4372-A567-0E02B2C3D479"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"valid-without-hyphens": {
+			validator: stringvalidator.IsUUID(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("f47ac10b58cc4372a5670e02b2c3d479"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"invalid": {
+			validator: stringvalidator.IsUUID(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("not-a-uuid"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be a valid UUID, such as "6ba7b810-9dad-11d1-80b4-00c04fd430c8", got: not-a-uuid`,
+					),
+				},
+			},
+		},
+		"invalid-non-hex": {
+			validator: stringvalidator.IsUUID(),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("g47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be a valid UUID, such as "6ba7b810-9dad-11d1-80b4-00c04fd430c8", got: g47ac10b-58cc-4372-a567-0e02b2c3d479`,
+					),
+				},
+			},
+		},
+		"version-valid": {
+			validator: stringvalidator.IsUUIDVersion(4),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("F47AC10B-58CC-4372-A567-0E02B2C3D479"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"version-invalid": {
+			validator: stringvalidator.IsUUIDVersion(4),
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be a valid version 4 UUID, such as "xxxxxxxx-xxxx-4xxx-xxxx-xxxxxxxxxxxx", got version 1 UUID: 6ba7b810-9dad-11d1-80b4-00c04fd430c8`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			testCase.validator.ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// NotEmpty returns a validator which ensures that any configured string value
+// is not the empty string. Null and unknown values are skipped, which
+// distinguishes an attribute which is not configured from one which is
+// configured with an empty string.
+func NotEmpty() validator.String {
+	return notEmptyValidator{}
+}
+
+// notEmptyValidator implements the validator.
+type notEmptyValidator struct{}
+
+// Description returns a plaintext description of the validator.
+func (v notEmptyValidator) Description(_ context.Context) string {
+	return "string must not be empty"
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v notEmptyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements the validation logic.
+func (v notEmptyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue),
+		)
+	}
+}
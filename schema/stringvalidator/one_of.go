@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// oneOfMaxSuggestionDistance is the maximum edit distance between the
+// configured value and an allowed value for the allowed value to be suggested.
+const oneOfMaxSuggestionDistance = 3
+
+// OneOf returns a validator which ensures that any configured string value
+// matches one of the given allowed values. Null and unknown values are
+// skipped.
+//
+// If the value does not match, but is close to an allowed value, such as
+// a typo, the error diagnostic implements diag.DiagnosticWithSuggestion and
+// suggests the closest allowed value by edit distance.
+func OneOf(values ...string) validator.String {
+	return oneOfValidator{
+		values: values,
+	}
+}
+
+// oneOfValidator implements the validator.
+type oneOfValidator struct {
+	values []string
+}
+
+// Description returns a plaintext description of the validator.
+func (v oneOfValidator) Description(_ context.Context) string {
+	quoted := make([]string, 0, len(v.values))
+
+	for _, value := range v.values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+
+	return fmt.Sprintf("value must be one of: %s", strings.Join(quoted, ", "))
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v oneOfValidator) MarkdownDescription(_ context.Context) string {
+	quoted := make([]string, 0, len(v.values))
+
+	for _, value := range v.values {
+		quoted = append(quoted, fmt.Sprintf("`%s`", value))
+	}
+
+	return fmt.Sprintf("value must be one of: %s", strings.Join(quoted, ", "))
+}
+
+// ValidateString implements the validation logic.
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	for _, allowed := range v.values {
+		if value == allowed {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(diag.NewAttributeErrorDiagnosticWithSuggestion(
+		req.Path,
+		"Invalid Attribute Value Match",
+		fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		v.suggestion(value),
+	))
+}
+
+// suggestion returns the allowed value with the smallest edit distance to
+// the given value, if the distance is small enough to likely be a typo.
+// Otherwise, an empty string is returned.
+func (v oneOfValidator) suggestion(value string) string {
+	var result string
+
+	resultDistance := oneOfMaxSuggestionDistance + 1
+
+	for _, allowed := range v.values {
+		distance := editDistance(value, allowed)
+
+		// Prevent suggesting wholly different short values, such as "b" for
+		// "a".
+		if distance >= len([]rune(allowed)) {
+			continue
+		}
+
+		if distance < resultDistance {
+			result = allowed
+			resultDistance = distance
+		}
+	}
+
+	return result
+}
+
+// editDistance returns the Levenshtein distance between the given strings,
+// which is the minimum number of single character insertions, deletions, or
+// substitutions to change one string into the other.
+func editDistance(a string, b string) int {
+	aRunes := []rune(a)
+	bRunes := []rune(b)
+
+	previous := make([]int, len(bRunes)+1)
+	current := make([]int, len(bRunes)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(aRunes); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(bRunes); j++ {
+			cost := 1
+
+			if aRunes[i-1] == bRunes[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(bRunes)]
+}
+
+// minInt returns the smallest of the given integers.
+func minInt(first int, others ...int) int {
+	result := first
+
+	for _, other := range others {
+		if other < result {
+			result = other
+		}
+	}
+
+	return result
+}
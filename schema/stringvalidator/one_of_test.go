@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOneOfValidatorValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.StringRequest
+		expected *validator.StringResponse
+	}{
+		"null": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"match": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("standard"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"near-miss": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("standrad"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnosticWithSuggestion(
+						path.Root("test"),
+						"Invalid Attribute Value Match",
+						`Attribute test value must be one of: "standard", "premium", "archive", got: "standrad"`,
+						"standard",
+					),
+				},
+			},
+		},
+		"near-miss-case": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("Premium"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnosticWithSuggestion(
+						path.Root("test"),
+						"Invalid Attribute Value Match",
+						`Attribute test value must be one of: "standard", "premium", "archive", got: "Premium"`,
+						"premium",
+					),
+				},
+			},
+		},
+		"far-miss": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("something-else"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value Match",
+						`Attribute test value must be one of: "standard", "premium", "archive", got: "something-else"`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			stringvalidator.OneOf("standard", "premium", "archive").ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOneOfValidatorValidateString_suggestion(t *testing.T) {
+	t.Parallel()
+
+	req := validator.StringRequest{
+		Path:        path.Root("test"),
+		ConfigValue: types.StringValue("archiv"),
+	}
+	resp := &validator.StringResponse{}
+
+	stringvalidator.OneOf("standard", "premium", "archive").ValidateString(context.Background(), req, resp)
+
+	if len(resp.Diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, got: %d", len(resp.Diagnostics))
+	}
+
+	diagWithSuggestion, ok := resp.Diagnostics[0].(diag.DiagnosticWithSuggestion)
+
+	if !ok {
+		t.Fatalf("expected diag.DiagnosticWithSuggestion, got: %T", resp.Diagnostics[0])
+	}
+
+	if diff := cmp.Diff(diagWithSuggestion.Suggestion(), "archive"); diff != "" {
+		t.Errorf("unexpected suggestion difference: %s", diff)
+	}
+
+	expectedDetail := `Attribute test value must be one of: "standard", "premium", "archive", got: "archiv"` + "\n\n" +
+		`Did you mean "archive"?`
+
+	if diff := cmp.Diff(diagWithSuggestion.Detail(), expectedDetail); diff != "" {
+		t.Errorf("unexpected detail difference: %s", diff)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// RegexMatchesAny returns a validator which ensures that any configured
+// string value matches at least one of the given regular expressions. Null
+// and unknown values are skipped.
+//
+// The optional message replaces the default description of the regular
+// expressions in the validator description and diagnostic, which is useful
+// when the expressions are difficult for practitioners to read.
+func RegexMatchesAny(patterns []*regexp.Regexp, message string) validator.String {
+	return regexMatchesAnyValidator{
+		message: message,
+		regexps: patterns,
+	}
+}
+
+// regexMatchesAnyValidator implements the validator.
+type regexMatchesAnyValidator struct {
+	message string
+	regexps []*regexp.Regexp
+}
+
+// Description returns a plaintext description of the validator.
+func (v regexMatchesAnyValidator) Description(_ context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	expressions := make([]string, 0, len(v.regexps))
+
+	for _, re := range v.regexps {
+		expressions = append(expressions, fmt.Sprintf("%q", re))
+	}
+
+	return "value must match at least one of the regular expressions: " + strings.Join(expressions, ", ")
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v regexMatchesAnyValidator) MarkdownDescription(_ context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	expressions := make([]string, 0, len(v.regexps))
+
+	for _, re := range v.regexps {
+		expressions = append(expressions, "`"+re.String()+"`")
+	}
+
+	return "value must match at least one of the regular expressions: " + strings.Join(expressions, ", ")
+}
+
+// ValidateString implements the validation logic.
+func (v regexMatchesAnyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	for _, re := range v.regexps {
+		if re.MatchString(value) {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value Match",
+		fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+	)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRegexMatchesAnyValidatorValidateString(t *testing.T) {
+	t.Parallel()
+
+	testRegexps := []*regexp.Regexp{
+		regexp.MustCompile(`^[a-z]+$`),
+		regexp.MustCompile(`^[0-9]+$`),
+	}
+
+	testCases := map[string]struct {
+		regexps  []*regexp.Regexp
+		message  string
+		request  validator.StringRequest
+		expected *validator.StringResponse
+	}{
+		"null": {
+			regexps: testRegexps,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown": {
+			regexps: testRegexps,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"matches-first": {
+			regexps: testRegexps,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("abc"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"matches-second": {
+			regexps: testRegexps,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("123"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"matches-none": {
+			regexps: testRegexps,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("abc123"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value Match",
+						`Attribute test value must match at least one of the regular expressions: "^[a-z]+$", "^[0-9]+$", got: abc123`,
+					),
+				},
+			},
+		},
+		"matches-none-message": {
+			regexps: testRegexps,
+			message: "value must contain only lowercase letters or only digits",
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("abc123"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value Match",
+						`Attribute test value must contain only lowercase letters or only digits, got: abc123`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			stringvalidator.RegexMatchesAny(testCase.regexps, testCase.message).ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// RuneLengthBetween returns a validator which ensures that any configured
+// string value has a length, counted in Unicode characters (runes) rather
+// than bytes, of at least minLength and at most maxLength. Multi-byte
+// characters, such as "é", count as a single character. Null and unknown
+// values are skipped. A negative minLength or a maxLength less than
+// minLength is a provider error and returns an error diagnostic for any
+// value.
+func RuneLengthBetween(minLength int, maxLength int) validator.String {
+	return runeLengthBetweenValidator{
+		maxLength: maxLength,
+		minLength: minLength,
+	}
+}
+
+// runeLengthBetweenValidator implements the validator.
+type runeLengthBetweenValidator struct {
+	maxLength int
+	minLength int
+}
+
+// Description returns a plaintext description of the validator.
+func (v runeLengthBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string length must be between %d and %d characters", v.minLength, v.maxLength)
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v runeLengthBetweenValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("string length must be between `%d` and `%d` characters", v.minLength, v.maxLength)
+}
+
+// ValidateString implements the validation logic.
+func (v runeLengthBetweenValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if v.minLength < 0 || v.maxLength < v.minLength {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Validator Configuration",
+			"While validating the string length, the minimum length was negative or greater than the maximum length. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Minimum Length: %d\nMaximum Length: %d", v.minLength, v.maxLength),
+		)
+
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	length := utf8.RuneCountInString(value)
+
+	if length < v.minLength || length > v.maxLength {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Length",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), length),
+		)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRuneLengthBetweenValidatorValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		minLength int
+		maxLength int
+		request   validator.StringRequest
+		expected  *validator.StringResponse
+	}{
+		"null": {
+			minLength: 1,
+			maxLength: 3,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown": {
+			minLength: 1,
+			maxLength: 3,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"valid-ascii": {
+			minLength: 1,
+			maxLength: 3,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("abc"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"valid-multi-byte": {
+			// "héé" is 3 runes, but 5 bytes.
+			minLength: 1,
+			maxLength: 3,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("héé"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"valid-multi-byte-emoji": {
+			// "🚀" is 1 rune, but 4 bytes.
+			minLength: 1,
+			maxLength: 1,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("🚀"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"invalid-too-short": {
+			minLength: 2,
+			maxLength: 3,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("é"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value Length",
+						"Attribute test string length must be between 2 and 3 characters, got: 1",
+					),
+				},
+			},
+		},
+		"invalid-too-long": {
+			minLength: 1,
+			maxLength: 3,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("héééé"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value Length",
+						"Attribute test string length must be between 1 and 3 characters, got: 5",
+					),
+				},
+			},
+		},
+		"invalid-configuration": {
+			minLength: 3,
+			maxLength: 1,
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("ab"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Validator Configuration",
+						"While validating the string length, the minimum length was negative or greater than the maximum length. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Minimum Length: 3\nMaximum Length: 1",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			stringvalidator.RuneLengthBetween(testCase.minLength, testCase.maxLength).ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
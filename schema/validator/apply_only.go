@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validator
+
+import (
+	"context"
+)
+
+// ApplyOnly is an optional interface for validators of any value type, which
+// should only be called once the configuration value is wholly known. Terraform
+// can validate configuration while values are still unknown, such as during
+// plan when a value references an attribute of another resource which has not
+// yet been created. The same validation is performed again during apply, when
+// those values are known.
+//
+// If the ApplyOnly method returns true, the framework skips calling the
+// validator when the configuration value is unknown or contains unknown
+// values, such as an unknown list element or object attribute.
+type ApplyOnly interface {
+	// ApplyOnly should return true if the validator should only be called
+	// with wholly known configuration values.
+	ApplyOnly(context.Context) bool
+}
@@ -35,6 +35,12 @@ type Int64Request struct {
 
 	// ConfigValue contains the value of the attribute for validation from the configuration.
 	ConfigValue types.Int64
+
+	// TypeName is the type name of the data source or resource being
+	// validated, such as "examplecloud_thing". It is empty when validating
+	// provider configuration. This can be used by shared validators to tailor
+	// diagnostic messages or behavior per data source or resource.
+	TypeName string
 }
 
 // Int64Response is a response to a Int64Request.
@@ -43,4 +49,11 @@ type Int64Response struct {
 	// source configuration. An empty slice indicates success, with no warnings
 	// or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// SkipRemainingValidators, when set to true without error diagnostics,
+	// prevents any further validators for the attribute from being called.
+	// This is useful for validators which determine the value is valid on
+	// their own, such as when any one of multiple conditions is satisfied.
+	// Other validation, such as nested attribute validation, is unaffected.
+	SkipRemainingValidators bool
 }
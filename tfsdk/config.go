@@ -31,10 +31,28 @@ func (c Config) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 //
 // Attributes or elements under null or unknown collections return null
 // values, however this behavior is not protected by compatibility promises.
+//
+// A single nested block can be read into a pointer to a struct, such as a
+// `**MyStruct` target, which is set to nil when the block is null.
 func (c Config) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
 	return c.data().GetAtPath(ctx, path, target)
 }
 
+// GetConfigAttribute returns the attribute or block found at the given path
+// as a new value of type T, such as types.String, *string, or a struct whose
+// fields are tagged with the corresponding nested attribute names. This is
+// equivalent to calling Config.GetAttribute with a pointer to a value of type
+// T, while enabling the compiler to infer the result type.
+//
+//	name, diags := tfsdk.GetConfigAttribute[types.String](ctx, req.Config, path.Root("name"))
+func GetConfigAttribute[T any](ctx context.Context, c Config, p path.Path) (T, diag.Diagnostics) {
+	var result T
+
+	diags := c.GetAttribute(ctx, p, &result)
+
+	return result, diags
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -126,6 +127,10 @@ func TestConfigGet(t *testing.T) {
 func TestConfigGetAttribute(t *testing.T) {
 	t.Parallel()
 
+	type testBlock struct {
+		Nested types.String `tfsdk:"nested"`
+	}
+
 	type testCase struct {
 		config        tfsdk.Config
 		target        interface{}
@@ -180,6 +185,82 @@ func TestConfigGetAttribute(t *testing.T) {
 			expected:      &testtypes.String{InternalString: types.StringValue("namevalue"), CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
+		"single-block-null-pointer": {
+			config: tfsdk.Config{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"nested": tftypes.String,
+							},
+						},
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"nested": tftypes.String,
+						},
+					}, nil),
+				}),
+				Schema: testschema.Schema{
+					Blocks: map[string]fwschema.Block{
+						"name": testschema.Block{
+							NestedObject: testschema.NestedBlockObject{
+								Attributes: map[string]fwschema.Attribute{
+									"nested": testschema.Attribute{
+										Type:     types.StringType,
+										Optional: true,
+									},
+								},
+							},
+							NestingMode: fwschema.BlockNestingModeSingle,
+						},
+					},
+				},
+			},
+			target:   new(*testBlock),
+			expected: new(*testBlock),
+		},
+		"single-block-pointer": {
+			config: tfsdk.Config{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"nested": tftypes.String,
+							},
+						},
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"nested": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"nested": tftypes.NewValue(tftypes.String, "nestedvalue"),
+					}),
+				}),
+				Schema: testschema.Schema{
+					Blocks: map[string]fwschema.Block{
+						"name": testschema.Block{
+							NestedObject: testschema.NestedBlockObject{
+								Attributes: map[string]fwschema.Attribute{
+									"nested": testschema.Attribute{
+										Type:     types.StringType,
+										Optional: true,
+									},
+								},
+							},
+							NestingMode: fwschema.BlockNestingModeSingle,
+						},
+					},
+				},
+			},
+			target: new(*testBlock),
+			expected: pointer(&testBlock{
+				Nested: types.StringValue("nestedvalue"),
+			}),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -200,6 +281,61 @@ func TestConfigGetAttribute(t *testing.T) {
 	}
 }
 
+func TestConfigGetAttribute_setValue(t *testing.T) {
+	t.Parallel()
+
+	config := tfsdk.Config{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"tags": tftypes.Set{ElementType: tftypes.String},
+			},
+		}, map[string]tftypes.Value{
+			"tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "first"),
+				tftypes.NewValue(tftypes.String, "second"),
+			}),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"tags": testschema.Attribute{
+					Type:     types.SetType{ElemType: types.StringType},
+					Required: true,
+				},
+			},
+		},
+	}
+
+	// Paths to set elements, such as those produced for set element
+	// validation, are found by matching an expression.
+	paths, diags := config.PathMatches(context.Background(), path.MatchRoot("tags").AtAnySetValue())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	elementPath := path.Root("tags").AtSetValue(types.StringValue("second"))
+
+	if !paths.Contains(elementPath) {
+		t.Fatalf("expected %s in path matches, got: %s", elementPath, paths)
+	}
+
+	if diff := cmp.Diff(elementPath.String(), `tags[Value("second")]`); diff != "" {
+		t.Errorf("unexpected path string difference: %s", diff)
+	}
+
+	var got types.String
+
+	diags = config.GetAttribute(context.Background(), elementPath, &got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(got, types.StringValue("second")); diff != "" {
+		t.Errorf("unexpected value difference: %s", diff)
+	}
+}
+
 func TestConfigPathMatches(t *testing.T) {
 	t.Parallel()
 
@@ -289,3 +425,147 @@ func TestConfigPathMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestGetConfigAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testObjectModel struct {
+		Nested types.String `tfsdk:"nested"`
+	}
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"nested": types.StringType,
+		},
+	}
+
+	config := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"object": tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"nested": tftypes.String,
+						},
+					},
+					"string": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"object": tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"nested": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"nested": tftypes.NewValue(tftypes.String, "nested-value"),
+					},
+				),
+				"string": tftypes.NewValue(tftypes.String, "test"),
+			},
+		),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"object": testschema.Attribute{
+					Optional: true,
+					Type:     objectType,
+				},
+				"string": testschema.Attribute{
+					Optional: true,
+					Type:     types.StringType,
+				},
+			},
+		},
+	}
+
+	t.Run("primitive-attr-value", func(t *testing.T) {
+		t.Parallel()
+
+		got, diags := tfsdk.GetConfigAttribute[types.String](context.Background(), config, path.Root("string"))
+
+		if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+			t.Errorf("unexpected diagnostics difference: %s", diff)
+		}
+
+		if diff := cmp.Diff(got, types.StringValue("test")); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	})
+
+	t.Run("primitive-go", func(t *testing.T) {
+		t.Parallel()
+
+		got, diags := tfsdk.GetConfigAttribute[string](context.Background(), config, path.Root("string"))
+
+		if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+			t.Errorf("unexpected diagnostics difference: %s", diff)
+		}
+
+		if diff := cmp.Diff(got, "test"); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	})
+
+	t.Run("object-attr-value", func(t *testing.T) {
+		t.Parallel()
+
+		got, diags := tfsdk.GetConfigAttribute[types.Object](context.Background(), config, path.Root("object"))
+
+		if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+			t.Errorf("unexpected diagnostics difference: %s", diff)
+		}
+
+		expected := types.ObjectValueMust(
+			objectType.AttrTypes,
+			map[string]attr.Value{
+				"nested": types.StringValue("nested-value"),
+			},
+		)
+
+		if diff := cmp.Diff(got, expected); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	})
+
+	t.Run("object-struct", func(t *testing.T) {
+		t.Parallel()
+
+		got, diags := tfsdk.GetConfigAttribute[testObjectModel](context.Background(), config, path.Root("object"))
+
+		if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+			t.Errorf("unexpected diagnostics difference: %s", diff)
+		}
+
+		expected := testObjectModel{
+			Nested: types.StringValue("nested-value"),
+		}
+
+		if diff := cmp.Diff(got, expected); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	})
+
+	t.Run("path-not-found", func(t *testing.T) {
+		t.Parallel()
+
+		got, diags := tfsdk.GetConfigAttribute[types.String](context.Background(), config, path.Root("missing"))
+
+		expectedDiags := diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("missing"),
+				"Configuration Read Error",
+				"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Error: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+			),
+		}
+
+		if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+			t.Errorf("unexpected diagnostics difference: %s", diff)
+		}
+
+		if diff := cmp.Diff(got, types.String{}); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	})
+}
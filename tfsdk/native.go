@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UnknownValue is the type of the Unknown sentinel.
+type UnknownValue struct{}
+
+// Unknown is the sentinel returned in place of unknown values when data is
+// converted into native Go values, such as with (Plan).AsMap. Compare values
+// against it with ==.
+var Unknown = UnknownValue{}
+
+// nativeValue returns the given Terraform value as a native Go value. Null
+// values are returned as nil, unknown values as Unknown, strings as string,
+// numbers as *big.Float, booleans as bool, lists, sets, and tuples as []any,
+// and maps and objects as map[string]any.
+func nativeValue(val tftypes.Value) (any, error) {
+	if !val.IsKnown() {
+		return Unknown, nil
+	}
+
+	if val.IsNull() {
+		return nil, nil
+	}
+
+	typ := val.Type()
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		err := val.As(&b)
+
+		return b, err
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+
+		err := val.As(&n)
+
+		return n, err
+	case typ.Is(tftypes.String):
+		var s string
+
+		err := val.As(&s)
+
+		return s, err
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		if err := val.As(&elements); err != nil {
+			return nil, err
+		}
+
+		result := make([]any, 0, len(elements))
+
+		for _, element := range elements {
+			native, err := nativeValue(element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, native)
+		}
+
+		return result, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		if err := val.As(&elements); err != nil {
+			return nil, err
+		}
+
+		result := make(map[string]any, len(elements))
+
+		for key, element := range elements {
+			native, err := nativeValue(element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = native
+		}
+
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported Terraform type: %s", typ)
+	}
+}
+
+// nativeMap returns the given Terraform object value as a map of native Go
+// values. A null value returns a nil map.
+func nativeMap(val tftypes.Value, description string) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if val.IsNull() {
+		return nil, diags
+	}
+
+	native, err := nativeValue(val)
+
+	if err != nil {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the "+description+" into native Go values. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	result, ok := native.(map[string]any)
+
+	if !ok {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the "+description+" into native Go values. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				fmt.Sprintf("Expected an object value, got: %T", native),
+		)
+
+		return nil, diags
+	}
+
+	return result, diags
+}
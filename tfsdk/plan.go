@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -19,6 +21,19 @@ type Plan struct {
 	Schema fwschema.Schema
 }
 
+// AsMap returns the entire plan as a map of native Go values, intended for
+// providers which generically process data without schema-specific types.
+// Nested attributes and blocks are converted recursively. Null values are
+// returned as nil, unknown values as the Unknown sentinel, strings as string,
+// numbers as *big.Float, booleans as bool, lists and sets as []any, and maps
+// and objects as map[string]any.
+//
+// A null plan, such as when the resource is planned for destruction, returns
+// a nil map.
+func (p Plan) AsMap(ctx context.Context) (map[string]any, diag.Diagnostics) {
+	return nativeMap(p.Raw, fwschemadata.DataDescriptionPlan.String())
+}
+
 // Get populates the struct passed as `target` with the entire plan.
 func (p Plan) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 	return p.data().Get(ctx, target)
@@ -46,10 +61,11 @@ func (p Plan) PathMatches(ctx context.Context, pathExpr path.Expression) (path.P
 
 // Set populates the entire plan using the supplied Go value. The value `val`
 // should be a struct whose values have one of the attr.Value types. Each field
-// must be tagged with the corresponding schema field.
-func (p *Plan) Set(ctx context.Context, val interface{}) diag.Diagnostics {
+// must be tagged with the corresponding schema field. Use ValueFromOption,
+// such as types.WithEmptyAsNull, to modify the reflection behavior.
+func (p *Plan) Set(ctx context.Context, val interface{}, opts ...basetypes.ValueFromOption) diag.Diagnostics {
 	data := p.data()
-	diags := data.Set(ctx, val)
+	diags := data.Set(ctx, val, reflect.NewOptions(opts...))
 
 	if diags.HasError() {
 		return diags
@@ -72,9 +88,37 @@ func (p *Plan) Set(ctx context.Context, val interface{}) diag.Diagnostics {
 // use (*string)(nil) or types.StringNull().
 //
 // Lists can only have the next element added according to the current length.
-func (p *Plan) SetAttribute(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
+//
+// Use ValueFromOption, such as types.WithEmptyAsNull, to modify the reflection
+// behavior.
+func (p *Plan) SetAttribute(ctx context.Context, path path.Path, val interface{}, opts ...basetypes.ValueFromOption) diag.Diagnostics {
 	data := p.data()
-	diags := data.SetAtPath(ctx, path, val)
+	diags := data.SetAtPath(ctx, path, val, reflect.NewOptions(opts...))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Raw = data.TerraformValue
+
+	return diags
+}
+
+// SetUnknown sets the attribute at each of the given paths to an unknown
+// value of the attribute type. This is intended for marking multiple computed
+// attributes for recomputation during plan modification.
+//
+// Each path must refer to a computed attribute with the current schema. Paths
+// to blocks or to positions within attributes, such as collection elements,
+// return an error diagnostic. The plan is unchanged if any error occurs.
+func (p *Plan) SetUnknown(ctx context.Context, paths ...path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data := p.data()
+
+	for _, unknownPath := range paths {
+		diags.Append(data.SetUnknownAtPath(ctx, unknownPath)...)
+	}
 
 	if diags.HasError() {
 		return diags
@@ -5,6 +5,7 @@ package tfsdk_test
 
 import (
 	"context"
+	"math/big"
 	"reflect"
 	"testing"
 
@@ -20,6 +21,111 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+func TestPlanAsMap(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed": tftypes.String,
+			"numbers":  tftypes.List{ElementType: tftypes.Number},
+		},
+	}
+
+	planType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"bool":   tftypes.Bool,
+			"id":     tftypes.String,
+			"nested": tftypes.Set{ElementType: nestedType},
+			"tags":   tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+
+	testCases := map[string]struct {
+		plan          tfsdk.Plan
+		expected      map[string]any
+		expectedDiags diag.Diagnostics
+	}{
+		"nested-with-unknowns": {
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(planType, map[string]tftypes.Value{
+					"bool": tftypes.NewValue(tftypes.Bool, true),
+					"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"nested": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+						tftypes.NewValue(nestedType, map[string]tftypes.Value{
+							"computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+							"numbers": tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{
+								tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+								tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+							}),
+						}),
+					}),
+					"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+						"env":   tftypes.NewValue(tftypes.String, "test"),
+						"owner": tftypes.NewValue(tftypes.String, nil),
+					}),
+				}),
+			},
+			expected: map[string]any{
+				"bool": true,
+				"id":   tfsdk.Unknown,
+				"nested": []any{
+					map[string]any{
+						"computed": tfsdk.Unknown,
+						"numbers": []any{
+							big.NewFloat(1.5),
+							tfsdk.Unknown,
+						},
+					},
+				},
+				"tags": map[string]any{
+					"env":   "test",
+					"owner": nil,
+				},
+			},
+		},
+		"unknown-collection": {
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(planType, map[string]tftypes.Value{
+					"bool":   tftypes.NewValue(tftypes.Bool, nil),
+					"id":     tftypes.NewValue(tftypes.String, "test"),
+					"nested": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, tftypes.UnknownValue),
+					"tags":   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				}),
+			},
+			expected: map[string]any{
+				"bool":   nil,
+				"id":     "test",
+				"nested": tfsdk.Unknown,
+				"tags":   nil,
+			},
+		},
+		"null": {
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(planType, nil),
+			},
+			expected: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.plan.AsMap(context.Background())
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected, cmp.Comparer(func(x, y *big.Float) bool { return x.Cmp(y) == 0 })); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestPlanGet(t *testing.T) {
 	t.Parallel()
 
@@ -388,6 +494,7 @@ func TestPlanSetAttribute(t *testing.T) {
 		plan          tfsdk.Plan
 		path          path.Path
 		val           interface{}
+		opts          []types.ValueFromOption
 		expected      tftypes.Value
 		expectedDiags diag.Diagnostics
 	}
@@ -432,6 +539,59 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, "should be untouched"),
 			}),
 		},
+		"empty-list": {
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tags": tftypes.List{ElementType: tftypes.String},
+					},
+				}, nil),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"tags": testschema.Attribute{
+							Type:     types.ListType{ElemType: types.StringType},
+							Optional: true,
+						},
+					},
+				},
+			},
+			path: path.Root("tags"),
+			val:  []string{},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags": tftypes.List{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+			}),
+		},
+		"empty-list-WithEmptyAsNull": {
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tags": tftypes.List{ElementType: tftypes.String},
+					},
+				}, nil),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"tags": testschema.Attribute{
+							Type:     types.ListType{ElemType: types.StringType},
+							Optional: true,
+						},
+					},
+				},
+			},
+			path: path.Root("tags"),
+			val:  []string{},
+			opts: []types.ValueFromOption{types.WithEmptyAsNull(true)},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags": tftypes.List{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+		},
 		"diagnostics": {
 			plan: tfsdk.Plan{
 				Raw: tftypes.NewValue(tftypes.Object{
@@ -470,7 +630,7 @@ func TestPlanSetAttribute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := tc.plan.SetAttribute(context.Background(), tc.path, tc.val)
+			diags := tc.plan.SetAttribute(context.Background(), tc.path, tc.val, tc.opts...)
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				for _, diagnostic := range diags {
@@ -485,3 +645,109 @@ func TestPlanSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanSetUnknown(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed_one": tftypes.String,
+			"computed_two": tftypes.Bool,
+			"other":        tftypes.String,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"computed_one": testschema.Attribute{
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"computed_two": testschema.Attribute{
+				Computed: true,
+				Type:     types.BoolType,
+			},
+			"other": testschema.Attribute{
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testPlanRaw := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"computed_one": tftypes.NewValue(tftypes.String, "one"),
+		"computed_two": tftypes.NewValue(tftypes.Bool, true),
+		"other":        tftypes.NewValue(tftypes.String, "should be untouched"),
+	})
+
+	type testCase struct {
+		plan          tfsdk.Plan
+		paths         []path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataSetUnknownAtPath for more exhaustive
+		// unit testing. These test cases are to ensure Plan schema and data
+		// values are passed appropriately to the shared implementation.
+		"no-paths": {
+			plan: tfsdk.Plan{
+				Raw:    testPlanRaw,
+				Schema: testSchema,
+			},
+			expected: testPlanRaw,
+		},
+		"multiple-paths": {
+			plan: tfsdk.Plan{
+				Raw:    testPlanRaw,
+				Schema: testSchema,
+			},
+			paths: []path.Path{
+				path.Root("computed_one"),
+				path.Root("computed_two"),
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"computed_one": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"computed_two": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+				"other":        tftypes.NewValue(tftypes.String, "should be untouched"),
+			}),
+		},
+		"non-computed-path": {
+			plan: tfsdk.Plan{
+				Raw:    testPlanRaw,
+				Schema: testSchema,
+			},
+			paths: []path.Path{
+				path.Root("computed_one"),
+				path.Root("other"),
+			},
+			expected: testPlanRaw,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("other"),
+					"Plan Write Error",
+					"An unexpected error was encountered trying to write an unknown value to the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: Unknown values can only be set on computed attributes.",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.plan.SetUnknown(context.Background(), tc.paths...)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.plan.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // State represents a Terraform state.
@@ -26,6 +28,20 @@ func (s State) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 	return s.data().Get(ctx, target)
 }
 
+// GetState returns the entire state as a new value of type T, which is
+// typically a struct whose fields are tagged with the corresponding schema
+// names. This is equivalent to calling State.Get with a pointer to a value of
+// type T, while enabling the compiler to infer the result type.
+//
+//	data, diags := tfsdk.GetState[exampleResourceModel](ctx, req.State)
+func GetState[T any](ctx context.Context, s State) (T, diag.Diagnostics) {
+	var result T
+
+	diags := s.Get(ctx, &result)
+
+	return result, diags
+}
+
 // GetAttribute retrieves the attribute or block found at `path` and populates
 // the `target` with the value. This method is intended for top level schema
 // attributes or blocks. Use `types` package methods or custom types to step
@@ -48,20 +64,21 @@ func (s State) PathMatches(ctx context.Context, pathExpr path.Expression) (path.
 
 // Set populates the entire state using the supplied Go value. The value `val`
 // should be a struct whose values have one of the attr.Value types. Each field
-// must be tagged with the corresponding schema field.
-func (s *State) Set(ctx context.Context, val interface{}) diag.Diagnostics {
+// must be tagged with the corresponding schema field. Use ValueFromOption,
+// such as types.WithEmptyAsNull, to modify the reflection behavior.
+func (s *State) Set(ctx context.Context, val interface{}, opts ...basetypes.ValueFromOption) diag.Diagnostics {
 	if val == nil {
 		err := fmt.Errorf("cannot set nil as entire state; to remove a resource from state, call State.RemoveResource, instead")
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
 				"State Read Error",
-				"An unexpected error was encountered trying to write the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to write the state. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			),
 		}
 	}
 
 	data := s.data()
-	diags := data.Set(ctx, val)
+	diags := data.Set(ctx, val, reflect.NewOptions(opts...))
 
 	if diags.HasError() {
 		return diags
@@ -72,6 +89,15 @@ func (s *State) Set(ctx context.Context, val interface{}) diag.Diagnostics {
 	return diags
 }
 
+// SetState populates the entire state using the supplied value of type T,
+// which is inferred from v. This is equivalent to calling State.Set with the
+// value. The type is not checked against any type used with GetState, so the
+// value must still be valid for the schema, which is checked when the value
+// is converted.
+func SetState[T any](ctx context.Context, s *State, v T, opts ...basetypes.ValueFromOption) diag.Diagnostics {
+	return s.Set(ctx, v, opts...)
+}
+
 // SetAttribute sets the attribute at `path` using the supplied Go value.
 //
 // The attribute path and value must be valid with the current schema. If the
@@ -84,9 +110,12 @@ func (s *State) Set(ctx context.Context, val interface{}) diag.Diagnostics {
 // use (*string)(nil) or types.StringNull().
 //
 // Lists can only have the next element added according to the current length.
-func (s *State) SetAttribute(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
+//
+// Use ValueFromOption, such as types.WithEmptyAsNull, to modify the reflection
+// behavior.
+func (s *State) SetAttribute(ctx context.Context, path path.Path, val interface{}, opts ...basetypes.ValueFromOption) diag.Diagnostics {
 	data := s.data()
-	diags := data.SetAtPath(ctx, path, val)
+	diags := data.SetAtPath(ctx, path, val, reflect.NewOptions(opts...))
 
 	if diags.HasError() {
 		return diags
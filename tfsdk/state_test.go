@@ -206,6 +206,7 @@ func TestStateSet(t *testing.T) {
 	type testCase struct {
 		state         tfsdk.State
 		val           interface{}
+		opts          []types.ValueFromOption
 		expected      tftypes.Value
 		expectedDiags diag.Diagnostics
 	}
@@ -245,6 +246,65 @@ func TestStateSet(t *testing.T) {
 				"name": tftypes.NewValue(tftypes.String, "newvalue"),
 			}),
 		},
+		"empty-list": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tags": tftypes.List{ElementType: tftypes.String},
+					},
+				}, nil),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"tags": testschema.Attribute{
+							Type:     types.ListType{ElemType: types.StringType},
+							Optional: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				Tags []string `tfsdk:"tags"`
+			}{
+				Tags: []string{},
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags": tftypes.List{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+			}),
+		},
+		"empty-list-WithEmptyAsNull": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tags": tftypes.List{ElementType: tftypes.String},
+					},
+				}, nil),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"tags": testschema.Attribute{
+							Type:     types.ListType{ElemType: types.StringType},
+							Optional: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				Tags []string `tfsdk:"tags"`
+			}{
+				Tags: []string{},
+			},
+			opts: []types.ValueFromOption{types.WithEmptyAsNull(true)},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags": tftypes.List{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+		},
 		"diagnostics": {
 			state: tfsdk.State{
 				Raw: tftypes.Value{},
@@ -278,7 +338,7 @@ func TestStateSet(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := tc.state.Set(context.Background(), tc.val)
+			diags := tc.state.Set(context.Background(), tc.val, tc.opts...)
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -298,6 +358,7 @@ func TestStateSetAttribute(t *testing.T) {
 		state         tfsdk.State
 		path          path.Path
 		val           interface{}
+		opts          []types.ValueFromOption
 		expected      tftypes.Value
 		expectedDiags diag.Diagnostics
 	}
@@ -342,6 +403,59 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, "should be untouched"),
 			}),
 		},
+		"empty-list": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tags": tftypes.List{ElementType: tftypes.String},
+					},
+				}, nil),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"tags": testschema.Attribute{
+							Type:     types.ListType{ElemType: types.StringType},
+							Optional: true,
+						},
+					},
+				},
+			},
+			path: path.Root("tags"),
+			val:  []string{},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags": tftypes.List{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+			}),
+		},
+		"empty-list-WithEmptyAsNull": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tags": tftypes.List{ElementType: tftypes.String},
+					},
+				}, nil),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"tags": testschema.Attribute{
+							Type:     types.ListType{ElemType: types.StringType},
+							Optional: true,
+						},
+					},
+				},
+			},
+			path: path.Root("tags"),
+			val:  []string{},
+			opts: []types.ValueFromOption{types.WithEmptyAsNull(true)},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags": tftypes.List{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+		},
 		"diagnostics": {
 			state: tfsdk.State{
 				Raw: tftypes.NewValue(tftypes.Object{
@@ -380,7 +494,7 @@ func TestStateSetAttribute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := tc.state.SetAttribute(context.Background(), tc.path, tc.val)
+			diags := tc.state.SetAttribute(context.Background(), tc.path, tc.val, tc.opts...)
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -392,3 +506,169 @@ func TestStateSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestGetState(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		Bool   types.Bool   `tfsdk:"bool"`
+		String types.String `tfsdk:"string"`
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"bool": testschema.Attribute{
+				Optional: true,
+				Type:     types.BoolType,
+			},
+			"string": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		state tfsdk.State
+	}{
+		"valid": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"bool":   tftypes.Bool,
+							"string": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"bool":   tftypes.NewValue(tftypes.Bool, true),
+						"string": tftypes.NewValue(tftypes.String, "test"),
+					},
+				),
+				Schema: testSchema,
+			},
+		},
+		"diagnostic": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"bool":   tftypes.Bool,
+							"string": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"bool":   tftypes.NewValue(tftypes.Bool, nil),
+						"string": tftypes.NewValue(tftypes.String, "test"),
+					},
+				),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"bool": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+						"string": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expected testModel
+
+			expectedDiags := testCase.state.Get(context.Background(), &expected)
+
+			got, diags := tfsdk.GetState[testModel](context.Background(), testCase.state)
+
+			if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetState(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		Bool   types.Bool   `tfsdk:"bool"`
+		String types.String `tfsdk:"string"`
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"bool": testschema.Attribute{
+				Optional: true,
+				Type:     types.BoolType,
+			},
+			"string": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		val testModel
+	}{
+		"known": {
+			val: testModel{
+				Bool:   types.BoolValue(true),
+				String: types.StringValue("test"),
+			},
+		},
+		"null": {
+			val: testModel{
+				Bool:   types.BoolNull(),
+				String: types.StringNull(),
+			},
+		},
+		"zero-value": {
+			val: testModel{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expected := &tfsdk.State{
+				Raw:    tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), nil),
+				Schema: testSchema,
+			}
+
+			expectedDiags := expected.Set(context.Background(), testCase.val)
+
+			got := &tfsdk.State{
+				Raw:    tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), nil),
+				Schema: testSchema,
+			}
+
+			diags := tfsdk.SetState(context.Background(), got, testCase.val)
+
+			if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -210,8 +210,8 @@ func TestValueAs(t *testing.T) {
 			),
 			target: &[]bool{},
 			expected: &[]bool{
-				true,
 				false,
+				true,
 			},
 		},
 		"struct framework types": {
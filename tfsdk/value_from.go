@@ -10,14 +10,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // ValueFrom takes the Go value `val` and populates `target` with an attr.Value,
 // based on the type definition provided in `targetType`.
 //
 // This is achieved using reflection rules provided by the internal/reflect package.
-func ValueFrom(ctx context.Context, val interface{}, targetType attr.Type, target interface{}) diag.Diagnostics {
-	v, diags := reflect.FromValue(ctx, targetType, val, path.Empty())
+// Use ValueFromOption, such as types.WithEmptyAsNull, to modify the reflection
+// behavior.
+func ValueFrom(ctx context.Context, val interface{}, targetType attr.Type, target interface{}, opts ...basetypes.ValueFromOption) diag.Diagnostics {
+	v, diags := reflect.FromValue(ctx, targetType, val, reflect.NewOptions(opts...), path.Empty())
 	if diags.HasError() {
 		return diags
 	}
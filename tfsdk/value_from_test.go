@@ -133,6 +133,7 @@ func TestValueFrom(t *testing.T) {
 
 	type testCase struct {
 		val           interface{}
+		opts          []types.ValueFromOption
 		target        attr.Value
 		expected      attr.Value
 		expectedDiags diag.Diagnostics
@@ -183,6 +184,17 @@ func TestValueFrom(t *testing.T) {
 				},
 			),
 		},
+		"empty-list": {
+			val:      []string{},
+			target:   types.ListNull(types.StringType),
+			expected: types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		"empty-list-WithEmptyAsNull": {
+			val:      []string{},
+			opts:     []types.ValueFromOption{types.WithEmptyAsNull(true)},
+			target:   types.ListNull(types.StringType),
+			expected: types.ListNull(types.StringType),
+		},
 		"incompatible-type": {
 			val:    0,
 			target: types.String{},
@@ -203,7 +215,7 @@ func TestValueFrom(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := ValueFrom(context.Background(), tc.val, tc.target.Type(context.Background()), &tc.target)
+			diags := ValueFrom(context.Background(), tc.val, tc.target.Type(context.Background()), &tc.target, tc.opts...)
 
 			if diff := cmp.Diff(tc.expectedDiags, diags); diff != "" {
 				t.Fatalf("Unexpected diff in diagnostics (-wanted, +got): %s", diff)
@@ -79,8 +79,7 @@ func (t BoolType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 	return NewBoolValue(v), nil
 }
 
-// ValueType returns the Value type.
+// ValueType returns the Value type, as a null value of this type.
 func (t BoolType) ValueType(_ context.Context) attr.Value {
-	// This Value does not need to be valid.
-	return BoolValue{}
+	return NewBoolNull()
 }
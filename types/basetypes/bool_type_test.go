@@ -77,3 +77,35 @@ func TestBoolTypeValueFromTerraform(t *testing.T) {
 		})
 	}
 }
+
+func TestBoolTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    BoolType
+		expected attr.Value
+	}{
+		"null": {
+			input:    BoolType{},
+			expected: NewBoolNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueType(context.Background())
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if !got.Type(context.Background()).Equal(testCase.input) {
+				t.Errorf("Expected type %s, got type %s", testCase.input, got.Type(context.Background()))
+			}
+		})
+	}
+}
@@ -67,7 +67,7 @@ func (t Float64Type) Validate(ctx context.Context, in tftypes.Value, path path.P
 		diags.AddAttributeError(
 			path,
 			"Float64 Type Validation Error",
-			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				fmt.Sprintf("Expected Number value, received %T with value: %v", in, in),
 		)
 		return diags
@@ -84,7 +84,7 @@ func (t Float64Type) Validate(ctx context.Context, in tftypes.Value, path path.P
 		diags.AddAttributeError(
 			path,
 			"Float64 Type Validation Error",
-			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				fmt.Sprintf("Cannot convert value to big.Float: %s", err),
 		)
 		return diags
@@ -155,11 +155,20 @@ func (t Float64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (
 		return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit floating point.", bigF)
 	}
 
-	return NewFloat64Value(f), nil
+	// Only preserve the original value if it has more precision than the
+	// float64, so values equal to a float64 match NewFloat64Value.
+	if accuracy == big.Exact {
+		return NewFloat64Value(f), nil
+	}
+
+	return Float64Value{
+		state:    attr.ValueStateKnown,
+		value:    f,
+		bigValue: bigF,
+	}, nil
 }
 
-// ValueType returns the Value type.
+// ValueType returns the Value type, as a null value of this type.
 func (t Float64Type) ValueType(_ context.Context) attr.Value {
-	// This Value does not need to be valid.
-	return Float64Value{}
+	return NewFloat64Null()
 }
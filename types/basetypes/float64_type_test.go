@@ -205,3 +205,35 @@ func TestFloat64TypeValueFromTerraform(t *testing.T) {
 		})
 	}
 }
+
+func TestFloat64TypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Float64Type
+		expected attr.Value
+	}{
+		"null": {
+			input:    Float64Type{},
+			expected: NewFloat64Null(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueType(context.Background())
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if !got.Type(context.Background()).Equal(testCase.input) {
+				t.Errorf("Expected type %s, got type %s", testCase.input, got.Type(context.Background()))
+			}
+		})
+	}
+}
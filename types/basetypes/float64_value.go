@@ -6,6 +6,9 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"math"
+	"math/big"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -82,6 +85,34 @@ func NewFloat64PointerValue(value *float64) Float64Value {
 	return NewFloat64Value(*value)
 }
 
+// NewFloat64ValueFromString creates a Float64 with a known value parsed from
+// the given string, such as numeric data read as text from an API response or
+// environment variable. If the string is empty, is not a number, overflows a
+// 64-bit floating point number, or is not finite, such as NaN or Inf, an
+// unknown value is returned with an error diagnostic.
+func NewFloat64ValueFromString(value string) (Float64Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parsed, err := strconv.ParseFloat(value, 64)
+
+	if err == nil && (math.IsNaN(parsed) || math.IsInf(parsed, 0)) {
+		err = fmt.Errorf("value must be a finite number, got: %s", value)
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Float64 String",
+			"While creating a Float64 value from a string, the string could not be parsed as a 64-bit floating point number.\n\n"+
+				fmt.Sprintf("String: %q\n", value)+
+				fmt.Sprintf("Error: %s", err),
+		)
+
+		return NewFloat64Unknown(), diags
+	}
+
+	return NewFloat64Value(parsed), diags
+}
+
 // Float64Value represents a 64-bit floating point value, exposed as a float64.
 type Float64Value struct {
 	// state represents whether the value is null, unknown, or known. The
@@ -90,9 +121,15 @@ type Float64Value struct {
 
 	// value contains the known value, if not null or unknown.
 	value float64
+
+	// bigValue contains the original known value, if created from a
+	// Terraform value which may have more precision than value.
+	bigValue *big.Float
 }
 
 // Equal returns true if `other` is a Float64 and has the same value as `f`.
+// If either value was created from a Terraform value, the values are compared
+// with the precision returned by ValueBigFloat.
 func (f Float64Value) Equal(other attr.Value) bool {
 	o, ok := other.(Float64Value)
 
@@ -108,13 +145,40 @@ func (f Float64Value) Equal(other attr.Value) bool {
 		return true
 	}
 
-	return f.value == o.value
+	if f.bigValue == nil && o.bigValue == nil {
+		return f.value == o.value
+	}
+
+	fBig, oBig := f.ValueBigFloat(), o.ValueBigFloat()
+
+	// NaN is never equal to another value.
+	if fBig == nil || oBig == nil {
+		return false
+	}
+
+	return fBig.Cmp(oBig) == 0
 }
 
 // ToTerraformValue returns the data contained in the Float64 as a tftypes.Value.
 func (f Float64Value) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	switch f.state {
 	case attr.ValueStateKnown:
+		// Terraform numbers cannot represent NaN or infinity, which would
+		// otherwise panic or create an invalid value during conversion.
+		if math.IsNaN(f.value) || math.IsInf(f.value, 0) {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), fmt.Errorf(
+				"Float64 value %v cannot be converted into a Terraform number, as NaN and infinite values are not supported; "+
+					"ensure the value is a finite number, such as by checking the result of the calculation creating it",
+				f.value,
+			)
+		}
+
+		// Preserve the original precision of values created from a
+		// Terraform value.
+		if f.bigValue != nil {
+			return tftypes.NewValue(tftypes.Number, new(big.Float).Copy(f.bigValue)), nil
+		}
+
 		if err := tftypes.ValidateValue(tftypes.Number, f.value); err != nil {
 			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
 		}
@@ -175,6 +239,33 @@ func (f Float64Value) ValueFloat64Pointer() *float64 {
 	return &f.value
 }
 
+// ValueBigFloat returns the known value as a *big.Float, or nil for a null or
+// unknown value. If the Float64 was created from a Terraform value, such as
+// configuration data, the original precision is preserved even if it cannot
+// be exactly represented by a float64. Otherwise, the shortest decimal
+// representation of the float64 is returned with the same precision as
+// Terraform values, so a float64 such as 0.1 matches the equivalent
+// configuration value.
+func (f Float64Value) ValueBigFloat() *big.Float {
+	if f.state != attr.ValueStateKnown {
+		return nil
+	}
+
+	if f.bigValue != nil {
+		return new(big.Float).Copy(f.bigValue)
+	}
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-go/blob/c593d2e0da8d2258b2a22af867c39842a0cb89f7/tftypes/value_msgpack.go#L108
+	bf, _, err := big.ParseFloat(strconv.FormatFloat(f.value, 'g', -1, 64), 10, 512, big.ToNearestEven)
+
+	// NaN cannot be represented as a *big.Float.
+	if err != nil {
+		return nil
+	}
+
+	return bf
+}
+
 // ToFloat64Value returns Float64.
 func (f Float64Value) ToFloat64Value(context.Context) (Float64Value, diag.Diagnostics) {
 	return f, nil
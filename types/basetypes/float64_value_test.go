@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -45,6 +46,14 @@ func TestFloat64ValueToTerraformValue(t *testing.T) {
 			input:       NewFloat64Value(123.456),
 			expectation: tftypes.NewValue(tftypes.Number, big.NewFloat(123.456)),
 		},
+		"known-float-ValueFromTerraform": {
+			input: Float64Value{
+				state:    attr.ValueStateKnown,
+				value:    0.1,
+				bigValue: testMustParseFloat("0.1000000000000000000000000001"),
+			},
+			expectation: tftypes.NewValue(tftypes.Number, testMustParseFloat("0.1000000000000000000000000001")),
+		},
 		"unknown": {
 			input:       NewFloat64Unknown(),
 			expectation: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
@@ -88,6 +97,50 @@ func TestFloat64ValueToTerraformValue(t *testing.T) {
 	}
 }
 
+func TestFloat64ValueToTerraformValue_nonFinite(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input         Float64Value
+		expectedError string
+	}{
+		"nan": {
+			input:         NewFloat64Value(math.NaN()),
+			expectedError: "Float64 value NaN cannot be converted into a Terraform number, as NaN and infinite values are not supported; ensure the value is a finite number, such as by checking the result of the calculation creating it",
+		},
+		"positive-infinity": {
+			input:         NewFloat64Value(math.Inf(1)),
+			expectedError: "Float64 value +Inf cannot be converted into a Terraform number, as NaN and infinite values are not supported; ensure the value is a finite number, such as by checking the result of the calculation creating it",
+		},
+		"negative-infinity": {
+			input:         NewFloat64Value(math.Inf(-1)),
+			expectedError: "Float64 value -Inf cannot be converted into a Terraform number, as NaN and infinite values are not supported; ensure the value is a finite number, such as by checking the result of the calculation creating it",
+		},
+	}
+
+	for name, testCase := range tests {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ToTerraformValue(context.Background())
+
+			if err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if got.IsKnown() {
+				t.Errorf("expected unknown value, got: %s", got)
+			}
+		})
+	}
+}
+
 func TestFloat64ValueEqual(t *testing.T) {
 	t.Parallel()
 
@@ -107,6 +160,50 @@ func TestFloat64ValueEqual(t *testing.T) {
 			candidate:   NewFloat64Value(456),
 			expectation: false,
 		},
+		"known-known-ValueFromTerraform-same": {
+			input: Float64Value{
+				state:    attr.ValueStateKnown,
+				value:    0.1,
+				bigValue: testMustParseFloat("0.1000000000000000000000000001"),
+			},
+			candidate: Float64Value{
+				state:    attr.ValueStateKnown,
+				value:    0.1,
+				bigValue: testMustParseFloat("0.1000000000000000000000000001"),
+			},
+			expectation: true,
+		},
+		"known-known-ValueFromTerraform-diff": {
+			input: Float64Value{
+				state:    attr.ValueStateKnown,
+				value:    0.1,
+				bigValue: testMustParseFloat("0.1000000000000000000000000001"),
+			},
+			candidate: Float64Value{
+				state:    attr.ValueStateKnown,
+				value:    0.1,
+				bigValue: testMustParseFloat("0.1000000000000000000000000002"),
+			},
+			expectation: false,
+		},
+		"known-known-ValueFromTerraform-float64-same": {
+			input: Float64Value{
+				state:    attr.ValueStateKnown,
+				value:    123.5,
+				bigValue: testMustParseFloat("123.5"),
+			},
+			candidate:   NewFloat64Value(123.5),
+			expectation: true,
+		},
+		"known-known-ValueFromTerraform-float64-diff": {
+			input: Float64Value{
+				state:    attr.ValueStateKnown,
+				value:    0.1,
+				bigValue: testMustParseFloat("0.1000000000000000000000000001"),
+			},
+			candidate:   NewFloat64Value(0.1),
+			expectation: false,
+		},
 		"known-unknown": {
 			input:       NewFloat64Value(123),
 			candidate:   NewFloat64Unknown(),
@@ -364,6 +461,63 @@ func TestFloat64ValueValueFloat64Pointer(t *testing.T) {
 	}
 }
 
+func TestFloat64ValueValueBigFloat(t *testing.T) {
+	t.Parallel()
+
+	// Exceeds the precision of a float64.
+	preciseBigFloat, _, err := big.ParseFloat("0.1000000000000000000000000001", 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		t.Fatalf("unexpected error parsing float: %s", err)
+	}
+
+	preciseValue, err := Float64Type{}.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.Number, preciseBigFloat))
+
+	if err != nil {
+		t.Fatalf("unexpected error creating value: %s", err)
+	}
+
+	testCases := map[string]struct {
+		input    Float64Value
+		expected *big.Float
+	}{
+		"known": {
+			input:    NewFloat64Value(2.4),
+			expected: testMustParseFloat("2.4"),
+		},
+		"known-ValueFromTerraform": {
+			input:    preciseValue.(Float64Value),
+			expected: preciseBigFloat,
+		},
+		"null": {
+			input:    NewFloat64Null(),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NewFloat64Unknown(),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueBigFloat()
+
+			if got == nil && testCase.expected == nil {
+				return
+			}
+
+			if got == nil || testCase.expected == nil || got.Cmp(testCase.expected) != 0 {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
 func TestNewFloat64PointerValue(t *testing.T) {
 	t.Parallel()
 
@@ -395,3 +549,104 @@ func TestNewFloat64PointerValue(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFloat64ValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         string
+		expected      Float64Value
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value:    "1.2",
+			expected: NewFloat64Value(1.2),
+		},
+		"valid-integer": {
+			value:    "123",
+			expected: NewFloat64Value(123),
+		},
+		"valid-exponent": {
+			value:    "-1.5e10",
+			expected: NewFloat64Value(-1.5e10),
+		},
+		"empty": {
+			value:    "",
+			expected: NewFloat64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Float64 String",
+					"While creating a Float64 value from a string, the string could not be parsed as a 64-bit floating point number.\n\n"+
+						`String: ""`+"\n"+
+						`Error: strconv.ParseFloat: parsing "": invalid syntax`,
+				),
+			},
+		},
+		"overflow": {
+			value:    "1e400",
+			expected: NewFloat64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Float64 String",
+					"While creating a Float64 value from a string, the string could not be parsed as a 64-bit floating point number.\n\n"+
+						`String: "1e400"`+"\n"+
+						`Error: strconv.ParseFloat: parsing "1e400": value out of range`,
+				),
+			},
+		},
+		"non-numeric": {
+			value:    "abc",
+			expected: NewFloat64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Float64 String",
+					"While creating a Float64 value from a string, the string could not be parsed as a 64-bit floating point number.\n\n"+
+						`String: "abc"`+"\n"+
+						`Error: strconv.ParseFloat: parsing "abc": invalid syntax`,
+				),
+			},
+		},
+		"nan": {
+			value:    "NaN",
+			expected: NewFloat64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Float64 String",
+					"While creating a Float64 value from a string, the string could not be parsed as a 64-bit floating point number.\n\n"+
+						`String: "NaN"`+"\n"+
+						`Error: value must be a finite number, got: NaN`,
+				),
+			},
+		},
+		"inf": {
+			value:    "-Inf",
+			expected: NewFloat64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Float64 String",
+					"While creating a Float64 value from a string, the string could not be parsed as a 64-bit floating point number.\n\n"+
+						`String: "-Inf"`+"\n"+
+						`Error: value must be a finite number, got: -Inf`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewFloat64ValueFromString(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -66,7 +66,7 @@ func (t Int64Type) Validate(ctx context.Context, in tftypes.Value, path path.Pat
 		diags.AddAttributeError(
 			path,
 			"Int64 Type Validation Error",
-			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				fmt.Sprintf("Expected Number value, received %T with value: %v", in, in),
 		)
 		return diags
@@ -83,7 +83,7 @@ func (t Int64Type) Validate(ctx context.Context, in tftypes.Value, path path.Pat
 		diags.AddAttributeError(
 			path,
 			"Int64 Type Validation Error",
-			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
 				fmt.Sprintf("Cannot convert value to big.Float: %s", err),
 		)
 		return diags
@@ -149,8 +149,7 @@ func (t Int64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (at
 	return NewInt64Value(i), nil
 }
 
-// ValueType returns the Value type.
+// ValueType returns the Value type, as a null value of this type.
 func (t Int64Type) ValueType(_ context.Context) attr.Value {
-	// This Value does not need to be valid.
-	return Int64Value{}
+	return NewInt64Null()
 }
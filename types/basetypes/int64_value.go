@@ -6,6 +6,8 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -76,6 +78,30 @@ func NewInt64PointerValue(value *int64) Int64Value {
 	return NewInt64Value(*value)
 }
 
+// NewInt64ValueFromString creates a Int64 with a known value parsed from the
+// given base 10 string, such as numeric data read as text from an API
+// response or environment variable. If the string is empty, is not an
+// integer, or overflows a 64-bit integer, an unknown value is returned with an
+// error diagnostic.
+func NewInt64ValueFromString(value string) (Int64Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parsed, err := strconv.ParseInt(value, 10, 64)
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Int64 String",
+			"While creating an Int64 value from a string, the string could not be parsed as a 64-bit integer.\n\n"+
+				fmt.Sprintf("String: %q\n", value)+
+				fmt.Sprintf("Error: %s", err),
+		)
+
+		return NewInt64Unknown(), diags
+	}
+
+	return NewInt64Value(parsed), diags
+}
+
 // Int64Value represents a 64-bit integer value, exposed as an int64.
 type Int64Value struct {
 	// state represents whether the value is null, unknown, or known. The
@@ -169,6 +195,16 @@ func (i Int64Value) ValueInt64Pointer() *int64 {
 	return &i.value
 }
 
+// ValueBigInt returns the known value as a *big.Int, or nil for a null or
+// unknown value.
+func (i Int64Value) ValueBigInt() *big.Int {
+	if i.state != attr.ValueStateKnown {
+		return nil
+	}
+
+	return big.NewInt(i.value)
+}
+
 // ToInt64Value returns Int64.
 func (i Int64Value) ToInt64Value(context.Context) (Int64Value, diag.Diagnostics) {
 	return i, nil
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -321,6 +322,46 @@ func TestInt64ValueValueInt64Pointer(t *testing.T) {
 	}
 }
 
+func TestInt64ValueValueBigInt(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Int64Value
+		expected *big.Int
+	}{
+		"known": {
+			input:    NewInt64Value(math.MaxInt64),
+			expected: big.NewInt(math.MaxInt64),
+		},
+		"null": {
+			input:    NewInt64Null(),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NewInt64Unknown(),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueBigInt()
+
+			if got == nil && testCase.expected == nil {
+				return
+			}
+
+			if got == nil || testCase.expected == nil || got.Cmp(testCase.expected) != 0 {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
 func TestNewInt64PointerValue(t *testing.T) {
 	t.Parallel()
 
@@ -352,3 +393,104 @@ func TestNewInt64PointerValue(t *testing.T) {
 		})
 	}
 }
+
+func TestNewInt64ValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         string
+		expected      Int64Value
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value:    "123",
+			expected: NewInt64Value(123),
+		},
+		"valid-negative": {
+			value:    "-123",
+			expected: NewInt64Value(-123),
+		},
+		"valid-max": {
+			value:    "9223372036854775807",
+			expected: NewInt64Value(math.MaxInt64),
+		},
+		"empty": {
+			value:    "",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Int64 String",
+					"While creating an Int64 value from a string, the string could not be parsed as a 64-bit integer.\n\n"+
+						`String: ""`+"\n"+
+						`Error: strconv.ParseInt: parsing "": invalid syntax`,
+				),
+			},
+		},
+		"overflow": {
+			value:    "9223372036854775808",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Int64 String",
+					"While creating an Int64 value from a string, the string could not be parsed as a 64-bit integer.\n\n"+
+						`String: "9223372036854775808"`+"\n"+
+						`Error: strconv.ParseInt: parsing "9223372036854775808": value out of range`,
+				),
+			},
+		},
+		"non-numeric": {
+			value:    "abc",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Int64 String",
+					"While creating an Int64 value from a string, the string could not be parsed as a 64-bit integer.\n\n"+
+						`String: "abc"`+"\n"+
+						`Error: strconv.ParseInt: parsing "abc": invalid syntax`,
+				),
+			},
+		},
+		"non-integer": {
+			value:    "1.5",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Int64 String",
+					"While creating an Int64 value from a string, the string could not be parsed as a 64-bit integer.\n\n"+
+						`String: "1.5"`+"\n"+
+						`Error: strconv.ParseInt: parsing "1.5": invalid syntax`,
+				),
+			},
+		},
+		"whitespace": {
+			value:    " 123",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Int64 String",
+					"While creating an Int64 value from a string, the string could not be parsed as a 64-bit integer.\n\n"+
+						`String: " 123"`+"\n"+
+						`Error: strconv.ParseInt: parsing " 123": invalid syntax`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewInt64ValueFromString(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
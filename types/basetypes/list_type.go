@@ -137,7 +137,7 @@ func (l ListType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 		diags.AddAttributeError(
 			path,
 			"List Type Validation Error",
-			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return diags
 	}
@@ -152,7 +152,7 @@ func (l ListType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 		diags.AddAttributeError(
 			path,
 			"List Type Validation Error",
-			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return diags
 	}
@@ -172,11 +172,9 @@ func (l ListType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 	return diags
 }
 
-// ValueType returns the Value type.
+// ValueType returns the Value type, as a null value of this type.
 func (l ListType) ValueType(_ context.Context) attr.Value {
-	return ListValue{
-		elementType: l.ElementType(),
-	}
+	return NewListNull(l.ElementType())
 }
 
 // ValueFromList returns a ListValuable type given a List.
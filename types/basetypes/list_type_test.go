@@ -342,3 +342,39 @@ func TestListTypeString(t *testing.T) {
 		})
 	}
 }
+
+func TestListTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ListType
+		expected attr.Value
+	}{
+		"ElemType-String": {
+			input:    ListType{ElemType: StringType{}},
+			expected: NewListNull(StringType{}),
+		},
+		"ElemType-List-Int64": {
+			input:    ListType{ElemType: ListType{ElemType: Int64Type{}}},
+			expected: NewListNull(ListType{ElemType: Int64Type{}}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueType(context.Background())
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if !got.Type(context.Background()).Equal(testCase.input) {
+				t.Errorf("Expected type %s, got type %s", testCase.input, got.Type(context.Background()))
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -96,11 +97,15 @@ func NewListValue(elementType attr.Type, elements []attr.Value) (ListValue, diag
 // NewListValueFrom creates a List with a known value, using reflection rules.
 // The elements must be a slice which can convert into the given element type.
 // Access the value via the List type Elements or ElementsAs methods.
-func NewListValueFrom(ctx context.Context, elementType attr.Type, elements any) (ListValue, diag.Diagnostics) {
+//
+// Use ValueFromOption, such as WithEmptyAsNull, to modify the reflection
+// behavior.
+func NewListValueFrom(ctx context.Context, elementType attr.Type, elements any, opts ...ValueFromOption) (ListValue, diag.Diagnostics) {
 	attrValue, diags := reflect.FromValue(
 		ctx,
 		ListType{ElemType: elementType},
 		elements,
+		reflect.NewOptions(opts...),
 		path.Empty(),
 	)
 
@@ -176,6 +181,19 @@ func (l ListValue) Elements() []attr.Value {
 // ElementsAs populates `target` with the elements of the ListValue, throwing an
 // error if the elements cannot be stored in `target`.
 func (l ListValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
+	return l.elementsAs(ctx, target, allowUnhandled, false)
+}
+
+// ElementsAsAll populates `target` with the elements of the ListValue, like
+// ElementsAs, except every element is converted even after an element cannot
+// be stored in `target`, so the diagnostics for all invalid elements are
+// returned with their element paths. If any element is invalid, `target` is
+// not modified.
+func (l ListValue) ElementsAsAll(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
+	return l.elementsAs(ctx, target, allowUnhandled, true)
+}
+
+func (l ListValue) elementsAs(ctx context.Context, target interface{}, allowUnhandled bool, collectAllElementErrors bool) diag.Diagnostics {
 	// we need a tftypes.Value for this List to be able to use it with our
 	// reflection code
 	values, err := l.ToTerraformValue(ctx)
@@ -183,16 +201,102 @@ func (l ListValue) ElementsAs(ctx context.Context, target interface{}, allowUnha
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
 				"List Element Conversion Error",
-				"An unexpected error was encountered trying to convert list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert list elements. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			),
 		}
 	}
 	return reflect.Into(ctx, ListType{ElemType: l.elementType}, values, target, reflect.Options{
+		CollectAllElementErrors: collectAllElementErrors,
 		UnhandledNullAsEmpty:    allowUnhandled,
 		UnhandledUnknownAsEmpty: allowUnhandled,
 	}, path.Empty())
 }
 
+// Range calls f for each element of the List in order, passing the element
+// index and value. Iteration stops early if f returns false. Unlike Elements
+// and ElementsAs, no intermediate copy of the elements is created, which
+// reduces allocations for large Lists. Null and unknown Lists have no
+// elements, so f is never called.
+func (l ListValue) Range(_ context.Context, f func(i int, v attr.Value) bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if f == nil {
+		diags.AddError(
+			"Missing List Range Function",
+			"While iterating a List value, a missing range function was detected. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return diags
+	}
+
+	for i, v := range l.elements {
+		if !f(i, v) {
+			break
+		}
+	}
+
+	return diags
+}
+
+// Append returns a new List with the given values added after the existing
+// elements. The existing List is not modified. See InsertAt for the handling
+// of null and unknown Lists and invalid values.
+func (l ListValue) Append(ctx context.Context, vals ...attr.Value) (ListValue, diag.Diagnostics) {
+	return l.InsertAt(ctx, len(l.elements), vals...)
+}
+
+// Prepend returns a new List with the given values added before the existing
+// elements. The existing List is not modified. See InsertAt for the handling
+// of null and unknown Lists and invalid values.
+func (l ListValue) Prepend(ctx context.Context, vals ...attr.Value) (ListValue, diag.Diagnostics) {
+	return l.InsertAt(ctx, 0, vals...)
+}
+
+// InsertAt returns a new List with the given values inserted before the
+// element at index i, which must be between zero and the number of elements.
+// An index equal to the number of elements adds the values after the
+// existing elements. The existing List is not modified.
+//
+// Null Lists are treated as empty Lists. Unknown Lists return an unknown
+// List. If the index is out of range or a value does not match the List
+// element type, an unknown List is returned with an error diagnostic.
+func (l ListValue) InsertAt(_ context.Context, i int, vals ...attr.Value) (ListValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if l.elementType == nil {
+		diags.AddError(
+			"Missing List Element Type",
+			"While inserting List elements, a missing element type was detected. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return l, diags
+	}
+
+	if l.IsUnknown() {
+		return NewListUnknown(l.elementType), diags
+	}
+
+	if i < 0 || i > len(l.elements) {
+		diags.AddError(
+			"Invalid List Index",
+			"While inserting List elements, an index outside the List elements was detected. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("List Index: %d\nList Length: %d", i, len(l.elements)),
+		)
+
+		return NewListUnknown(l.elementType), diags
+	}
+
+	elements := make([]attr.Value, 0, len(l.elements)+len(vals))
+	elements = append(elements, l.elements[:i]...)
+	elements = append(elements, vals...)
+	elements = append(elements, l.elements[i:]...)
+
+	return NewListValue(l.elementType, elements)
+}
+
 // ElementType returns the element type for the List.
 func (l ListValue) ElementType(_ context.Context) attr.Type {
 	return l.elementType
@@ -284,6 +388,72 @@ func (l ListValue) IsUnknown() bool {
 	return l.state == attr.ValueStateUnknown
 }
 
+// ContainsAll returns true if every candidate is equal to an element of the
+// List, as defined by the Equal method of the element. An empty candidates
+// slice always returns true. Null and unknown Lists return false for any
+// candidates, as they have no known elements.
+func (l ListValue) ContainsAll(_ context.Context, candidates []attr.Value) bool {
+	for _, candidate := range candidates {
+		if !l.contains(candidate) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (l ListValue) contains(v attr.Value) bool {
+	for _, elem := range l.elements {
+		if elem.Equal(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsNullOrEmpty returns true if the List represents a null value or a known
+// value without elements. Returns false if the List represents a currently
+// unknown value, as whether it will contain elements cannot be determined.
+func (l ListValue) IsNullOrEmpty() bool {
+	if l.IsNull() {
+		return true
+	}
+
+	return !l.IsUnknown() && len(l.elements) == 0
+}
+
+// Sort returns a new List containing the elements sorted according to the
+// less function, which should return true if the first element sorts before
+// the second element. The sort is stable, so equal elements keep their
+// original order. The existing List is not modified. Null and unknown Lists
+// are returned unchanged.
+func (l ListValue) Sort(_ context.Context, less func(a, b attr.Value) bool) (ListValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if l.state != attr.ValueStateKnown {
+		return l, diags
+	}
+
+	if less == nil {
+		diags.AddError(
+			"Missing List Sort Function",
+			"While sorting a List value, a missing less function was detected. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return l, diags
+	}
+
+	elements := l.Elements()
+
+	sort.SliceStable(elements, func(i, j int) bool {
+		return less(elements[i], elements[j])
+	})
+
+	return NewListValue(l.elementType, elements)
+}
+
 // String returns a human-readable representation of the List value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
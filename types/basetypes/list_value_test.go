@@ -89,6 +89,7 @@ func TestNewListValueFrom(t *testing.T) {
 	testCases := map[string]struct {
 		elementType   attr.Type
 		elements      any
+		opts          []ValueFromOption
 		expected      ListValue
 		expectedDiags diag.Diagnostics
 	}{
@@ -108,6 +109,27 @@ func TestNewListValueFrom(t *testing.T) {
 				[]attr.Value{},
 			),
 		},
+		"valid-StringType{}-[]types.String-empty-WithEmptyAsNull": {
+			elementType: StringType{},
+			elements:    []StringValue{},
+			opts:        []ValueFromOption{WithEmptyAsNull(true)},
+			expected:    NewListNull(StringType{}),
+		},
+		"valid-StringType{}-[]string-empty-WithEmptyAsNull": {
+			elementType: StringType{},
+			elements:    []string{},
+			opts:        []ValueFromOption{WithEmptyAsNull(true)},
+			expected:    NewListNull(StringType{}),
+		},
+		"valid-StringType{}-[]string-WithEmptyAsNull-false": {
+			elementType: StringType{},
+			elements:    []string{},
+			opts:        []ValueFromOption{WithEmptyAsNull(false)},
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{},
+			),
+		},
 		"valid-StringType{}-[]types.String": {
 			elementType: StringType{},
 			elements: []StringValue{
@@ -188,7 +210,7 @@ func TestNewListValueFrom(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := NewListValueFrom(context.Background(), testCase.elementType, testCase.elements)
+			got, diags := NewListValueFrom(context.Background(), testCase.elementType, testCase.elements, testCase.opts...)
 
 			if diff := cmp.Diff(got, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
@@ -665,6 +687,672 @@ func TestListValueIsUnknown(t *testing.T) {
 	}
 }
 
+func TestListValueContainsAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input      ListValue
+		candidates []attr.Value
+		expected   bool
+	}{
+		"all-present": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("first"),
+				NewStringValue("second"),
+				NewStringValue("third"),
+			}),
+			candidates: []attr.Value{
+				NewStringValue("third"),
+				NewStringValue("first"),
+			},
+			expected: true,
+		},
+		"some-missing": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("first"),
+				NewStringValue("second"),
+			}),
+			candidates: []attr.Value{
+				NewStringValue("first"),
+				NewStringValue("missing"),
+			},
+			expected: false,
+		},
+		"different-type": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("1"),
+			}),
+			candidates: []attr.Value{
+				NewInt64Value(1),
+			},
+			expected: false,
+		},
+		"empty-candidates": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("first"),
+			}),
+			candidates: []attr.Value{},
+			expected:   true,
+		},
+		"nil-candidates": {
+			input:      NewListValueMust(StringType{}, []attr.Value{}),
+			candidates: nil,
+			expected:   true,
+		},
+		"null": {
+			input: NewListNull(StringType{}),
+			candidates: []attr.Value{
+				NewStringValue("first"),
+			},
+			expected: false,
+		},
+		"unknown": {
+			input: NewListUnknown(StringType{}),
+			candidates: []attr.Value{
+				NewStringValue("first"),
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ContainsAll(context.Background(), testCase.candidates)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueIsNullOrEmpty(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ListValue
+		expected bool
+	}{
+		"known-empty": {
+			input:    NewListValueMust(StringType{}, []attr.Value{}),
+			expected: true,
+		},
+		"known-one-element": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			expected: false,
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsNullOrEmpty()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueRange(t *testing.T) {
+	t.Parallel()
+
+	type visit struct {
+		Index int
+		Value string
+	}
+
+	testCases := map[string]struct {
+		input          ListValue
+		stopAfter      int
+		expected       []visit
+		expectedDiags  diag.Diagnostics
+		missingRangeFn bool
+	}{
+		"known": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+				NewStringValue("bravo"),
+				NewStringValue("charlie"),
+			}),
+			stopAfter: -1,
+			expected: []visit{
+				{Index: 0, Value: "alpha"},
+				{Index: 1, Value: "bravo"},
+				{Index: 2, Value: "charlie"},
+			},
+		},
+		"known-early-termination": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+				NewStringValue("bravo"),
+				NewStringValue("charlie"),
+			}),
+			stopAfter: 2,
+			expected: []visit{
+				{Index: 0, Value: "alpha"},
+				{Index: 1, Value: "bravo"},
+			},
+		},
+		"null": {
+			input:     NewListNull(StringType{}),
+			stopAfter: -1,
+		},
+		"unknown": {
+			input:     NewListUnknown(StringType{}),
+			stopAfter: -1,
+		},
+		"missing-range-function": {
+			input:          NewListValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			missingRangeFn: true,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing List Range Function",
+					"While iterating a List value, a missing range function was detected. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []visit
+
+			f := func(i int, v attr.Value) bool {
+				got = append(got, visit{Index: i, Value: v.(StringValue).ValueString()})
+
+				return len(got) != testCase.stopAfter
+			}
+
+			if testCase.missingRangeFn {
+				f = nil
+			}
+
+			diags := testCase.input.Range(context.Background(), f)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueSort(t *testing.T) {
+	t.Parallel()
+
+	testLessString := func(a, b attr.Value) bool {
+		return a.(StringValue).ValueString() < b.(StringValue).ValueString()
+	}
+
+	testCases := map[string]struct {
+		input         ListValue
+		less          func(a, b attr.Value) bool
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("charlie"),
+				NewStringValue("alpha"),
+				NewStringValue("bravo"),
+			}),
+			less: testLessString,
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+				NewStringValue("bravo"),
+				NewStringValue("charlie"),
+			}),
+		},
+		"known-empty": {
+			input:    NewListValueMust(StringType{}, []attr.Value{}),
+			less:     testLessString,
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			less:     testLessString,
+			expected: NewListNull(StringType{}),
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			less:     testLessString,
+			expected: NewListUnknown(StringType{}),
+		},
+		"missing-less": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing List Sort Function",
+					"While sorting a List value, a missing less function was detected. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Sort(context.Background(), testCase.less)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueSort_immutable(t *testing.T) {
+	t.Parallel()
+
+	value := NewListValueMust(StringType{}, []attr.Value{
+		NewStringValue("bravo"),
+		NewStringValue("alpha"),
+	})
+
+	_, _ = value.Sort(context.Background(), func(a, b attr.Value) bool {
+		return a.(StringValue).ValueString() < b.(StringValue).ValueString()
+	})
+
+	expected := NewListValueMust(StringType{}, []attr.Value{
+		NewStringValue("bravo"),
+		NewStringValue("alpha"),
+	})
+
+	if !value.Equal(expected) {
+		t.Fatal("unexpected Sort mutation")
+	}
+}
+
+func TestListValueAppend(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		vals          []attr.Value
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+			}),
+			vals: []attr.Value{
+				NewStringValue("bravo"),
+				NewStringValue("charlie"),
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+				NewStringValue("bravo"),
+				NewStringValue("charlie"),
+			}),
+		},
+		"known-no-values": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+			}),
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+			}),
+		},
+		"null": {
+			input: NewListNull(StringType{}),
+			vals: []attr.Value{
+				NewStringValue("alpha"),
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+			}),
+		},
+		"unknown": {
+			input: NewListUnknown(StringType{}),
+			vals: []attr.Value{
+				NewStringValue("alpha"),
+			},
+			expected: NewListUnknown(StringType{}),
+		},
+		"type-mismatch": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+			}),
+			vals: []attr.Value{
+				NewInt64Value(1),
+			},
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"List Index (1) Element Type: basetypes.Int64Type",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Append(context.Background(), testCase.vals...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueAppend_zeroValue(t *testing.T) {
+	t.Parallel()
+
+	_, diags := ListValue{}.Append(context.Background(), NewStringValue("alpha"))
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Missing List Element Type",
+			"While inserting List elements, a missing element type was detected. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestListValuePrepend(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		vals          []attr.Value
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("charlie"),
+			}),
+			vals: []attr.Value{
+				NewStringValue("alpha"),
+				NewStringValue("bravo"),
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+				NewStringValue("bravo"),
+				NewStringValue("charlie"),
+			}),
+		},
+		"null": {
+			input: NewListNull(StringType{}),
+			vals: []attr.Value{
+				NewStringValue("alpha"),
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+			}),
+		},
+		"unknown": {
+			input: NewListUnknown(StringType{}),
+			vals: []attr.Value{
+				NewStringValue("alpha"),
+			},
+			expected: NewListUnknown(StringType{}),
+		},
+		"type-mismatch": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+			}),
+			vals: []attr.Value{
+				NewInt64Value(1),
+			},
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"List Index (0) Element Type: basetypes.Int64Type",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Prepend(context.Background(), testCase.vals...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueInsertAt(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		index         int
+		vals          []attr.Value
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known-middle": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+				NewStringValue("delta"),
+			}),
+			index: 1,
+			vals: []attr.Value{
+				NewStringValue("bravo"),
+				NewStringValue("charlie"),
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+				NewStringValue("bravo"),
+				NewStringValue("charlie"),
+				NewStringValue("delta"),
+			}),
+		},
+		"known-end": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+			}),
+			index: 1,
+			vals: []attr.Value{
+				NewStringValue("bravo"),
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+				NewStringValue("bravo"),
+			}),
+		},
+		"null": {
+			input: NewListNull(StringType{}),
+			index: 0,
+			vals: []attr.Value{
+				NewStringValue("alpha"),
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+			}),
+		},
+		"null-out-of-range": {
+			input: NewListNull(StringType{}),
+			index: 1,
+			vals: []attr.Value{
+				NewStringValue("alpha"),
+			},
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Index",
+					"While inserting List elements, an index outside the List elements was detected. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Index: 1\nList Length: 0",
+				),
+			},
+		},
+		"unknown": {
+			input: NewListUnknown(StringType{}),
+			index: 5,
+			vals: []attr.Value{
+				NewStringValue("alpha"),
+			},
+			expected: NewListUnknown(StringType{}),
+		},
+		"out-of-range-negative": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+			}),
+			index: -1,
+			vals: []attr.Value{
+				NewStringValue("bravo"),
+			},
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Index",
+					"While inserting List elements, an index outside the List elements was detected. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Index: -1\nList Length: 1",
+				),
+			},
+		},
+		"out-of-range-positive": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+			}),
+			index: 2,
+			vals: []attr.Value{
+				NewStringValue("bravo"),
+			},
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Index",
+					"While inserting List elements, an index outside the List elements was detected. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Index: 2\nList Length: 1",
+				),
+			},
+		},
+		"type-mismatch": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("alpha"),
+				NewStringValue("charlie"),
+			}),
+			index: 1,
+			vals: []attr.Value{
+				NewInt64Value(1),
+			},
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"List Index (1) Element Type: basetypes.Int64Type",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.InsertAt(context.Background(), testCase.index, testCase.vals...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueInsertAt_immutable(t *testing.T) {
+	t.Parallel()
+
+	value := NewListValueMust(StringType{}, []attr.Value{
+		NewStringValue("alpha"),
+		NewStringValue("charlie"),
+	})
+
+	_, _ = value.InsertAt(context.Background(), 1, NewStringValue("bravo"))
+
+	expected := NewListValueMust(StringType{}, []attr.Value{
+		NewStringValue("alpha"),
+		NewStringValue("charlie"),
+	})
+
+	if !value.Equal(expected) {
+		t.Fatal("unexpected InsertAt mutation")
+	}
+}
+
 func TestListValueString(t *testing.T) {
 	t.Parallel()
 
@@ -858,3 +1546,84 @@ func TestListTypeValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestListElementsAsAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input              ListValue
+		expected           []string
+		expectedDiags      diag.Diagnostics
+		expectedDiagsFirst diag.Diagnostics
+	}{
+		"valid": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("hello"),
+				NewStringValue("world"),
+			}),
+			expected: []string{"hello", "world"},
+		},
+		"invalid-multiple": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringNull(),
+				NewStringValue("hello"),
+				NewStringUnknown(),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtListIndex(0),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: [0]\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtListIndex(2),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: [2]\nTarget Type: string\nSuggested Type: basetypes.StringValue",
+				),
+			},
+			expectedDiagsFirst: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtListIndex(0),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: [0]\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			diags := testCase.input.ElementsAsAll(context.Background(), &got, false)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			// ElementsAs retains the existing behavior of returning only the
+			// first invalid element diagnostics.
+			var gotFirst []string
+
+			diags = testCase.input.ElementsAs(context.Background(), &gotFirst, false)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagsFirst); diff != "" {
+				t.Errorf("unexpected ElementsAs diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
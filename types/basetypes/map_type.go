@@ -140,7 +140,7 @@ func (m MapType) Validate(ctx context.Context, in tftypes.Value, path path.Path)
 		diags.AddAttributeError(
 			path,
 			"Map Type Validation Error",
-			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return diags
 	}
@@ -155,7 +155,7 @@ func (m MapType) Validate(ctx context.Context, in tftypes.Value, path path.Path)
 		diags.AddAttributeError(
 			path,
 			"Map Type Validation Error",
-			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return diags
 	}
@@ -175,11 +175,9 @@ func (m MapType) Validate(ctx context.Context, in tftypes.Value, path path.Path)
 	return diags
 }
 
-// ValueType returns the Value type.
+// ValueType returns the Value type, as a null value of this type.
 func (m MapType) ValueType(_ context.Context) attr.Value {
-	return MapValue{
-		elementType: m.ElementType(),
-	}
+	return NewMapNull(m.ElementType())
 }
 
 // ValueFromMap returns a MapValuable type given a Map.
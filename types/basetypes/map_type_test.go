@@ -319,3 +319,39 @@ func TestMapTypeString(t *testing.T) {
 		})
 	}
 }
+
+func TestMapTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    MapType
+		expected attr.Value
+	}{
+		"ElemType-String": {
+			input:    MapType{ElemType: StringType{}},
+			expected: NewMapNull(StringType{}),
+		},
+		"ElemType-List-Int64": {
+			input:    MapType{ElemType: ListType{ElemType: Int64Type{}}},
+			expected: NewMapNull(ListType{ElemType: Int64Type{}}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueType(context.Background())
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if !got.Type(context.Background()).Equal(testCase.input) {
+				t.Errorf("Expected type %s, got type %s", testCase.input, got.Type(context.Background()))
+			}
+		})
+	}
+}
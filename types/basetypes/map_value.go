@@ -98,11 +98,15 @@ func NewMapValue(elementType attr.Type, elements map[string]attr.Value) (MapValu
 // The elements must be a map of string keys to values which can convert into
 // the given element type. Access the value via the Map type Elements or
 // ElementsAs methods.
-func NewMapValueFrom(ctx context.Context, elementType attr.Type, elements any) (MapValue, diag.Diagnostics) {
+//
+// Use ValueFromOption, such as WithEmptyAsNull, to modify the reflection
+// behavior.
+func NewMapValueFrom(ctx context.Context, elementType attr.Type, elements any, opts ...ValueFromOption) (MapValue, diag.Diagnostics) {
 	attrValue, diags := reflect.FromValue(
 		ctx,
 		MapType{ElemType: elementType},
 		elements,
+		reflect.NewOptions(opts...),
 		path.Empty(),
 	)
 
@@ -181,6 +185,19 @@ func (m MapValue) Elements() map[string]attr.Value {
 // ElementsAs populates `target` with the elements of the MapValue, throwing an
 // error if the elements cannot be stored in `target`.
 func (m MapValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
+	return m.elementsAs(ctx, target, allowUnhandled, false)
+}
+
+// ElementsAsAll populates `target` with the elements of the MapValue, like
+// ElementsAs, except every element is converted even after an element cannot
+// be stored in `target`, so the diagnostics for all invalid elements are
+// returned with their element paths. If any element is invalid, `target` is
+// not modified.
+func (m MapValue) ElementsAsAll(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
+	return m.elementsAs(ctx, target, allowUnhandled, true)
+}
+
+func (m MapValue) elementsAs(ctx context.Context, target interface{}, allowUnhandled bool, collectAllElementErrors bool) diag.Diagnostics {
 	// we need a tftypes.Value for this Map to be able to use it with our
 	// reflection code
 	val, err := m.ToTerraformValue(ctx)
@@ -189,12 +206,13 @@ func (m MapValue) ElementsAs(ctx context.Context, target interface{}, allowUnhan
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
 				"Map Conversion Error",
-				"An unexpected error was encountered trying to convert the map into an equivalent Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert the map into an equivalent Terraform value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			),
 		}
 	}
 
 	return reflect.Into(ctx, MapType{ElemType: m.elementType}, val, target, reflect.Options{
+		CollectAllElementErrors: collectAllElementErrors,
 		UnhandledNullAsEmpty:    allowUnhandled,
 		UnhandledUnknownAsEmpty: allowUnhandled,
 	}, path.Empty())
@@ -218,8 +236,10 @@ func (m MapValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	case attr.ValueStateKnown:
 		vals := make(map[string]tftypes.Value, len(m.elements))
 
-		for key, elem := range m.elements {
-			val, err := elem.ToTerraformValue(ctx)
+		// Convert elements in a deterministic order, so any conversion error
+		// is consistently reported for the same element.
+		for _, key := range sortedMapKeys(m.elements) {
+			val, err := m.elements[key].ToTerraformValue(ctx)
 
 			if err != nil {
 				return tftypes.NewValue(mapType, tftypes.UnknownValue), err
@@ -291,6 +311,79 @@ func (m MapValue) IsUnknown() bool {
 	return m.state == attr.ValueStateUnknown
 }
 
+// IsNullOrEmpty returns true if the Map represents a null value or a known
+// value without elements. Returns false if the Map represents a currently
+// unknown value, as whether it will contain elements cannot be determined.
+func (m MapValue) IsNullOrEmpty() bool {
+	if m.IsNull() {
+		return true
+	}
+
+	return !m.IsUnknown() && len(m.elements) == 0
+}
+
+// Merge returns a new Map containing the elements of the Map and the elements
+// of the other Map, where elements of the other Map override elements with
+// the same key. Both Maps must have the same element type. A null Map is
+// treated as an empty Map when the other Map is known, merging two null Maps
+// returns a null Map, and an unknown Map causes the result to be unknown.
+// Neither existing Map is modified.
+func (m MapValue) Merge(_ context.Context, other MapValue) (MapValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.elementType == nil || other.elementType == nil {
+		diags.AddError(
+			"Invalid Map Merge Element Type",
+			"While merging Map values, a missing element type was detected. "+
+				"Map values must be created with an element type, such as with NewMapValue() or NewMapNull(), rather than as a zero-value Map. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Map Element Type: %v\n", m.elementType)+
+				fmt.Sprintf("Other Map Element Type: %v", other.elementType),
+		)
+
+		elementType := m.elementType
+
+		if elementType == nil {
+			elementType = other.elementType
+		}
+
+		return NewMapUnknown(elementType), diags
+	}
+
+	if !m.elementType.Equal(other.elementType) {
+		diags.AddError(
+			"Invalid Map Merge Element Type",
+			"While merging Map values, an invalid element type was detected. "+
+				"Both Map values must use a matching element type. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Map Element Type: %s\n", m.elementType)+
+				fmt.Sprintf("Other Map Element Type: %s", other.elementType),
+		)
+
+		return NewMapUnknown(m.elementType), diags
+	}
+
+	if m.IsUnknown() || other.IsUnknown() {
+		return NewMapUnknown(m.elementType), diags
+	}
+
+	if m.IsNull() && other.IsNull() {
+		return NewMapNull(m.elementType), diags
+	}
+
+	elements := make(map[string]attr.Value, len(m.elements)+len(other.elements))
+
+	for key, value := range m.elements {
+		elements[key] = value
+	}
+
+	for key, value := range other.elements {
+		elements[key] = value
+	}
+
+	return NewMapValue(m.elementType, elements)
+}
+
 // String returns a human-readable representation of the Map value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
@@ -303,21 +396,15 @@ func (m MapValue) String() string {
 		return attr.NullValueString
 	}
 
-	// We want the output to be consistent, so we sort the output by key
-	keys := make([]string, 0, len(m.Elements()))
-	for k := range m.Elements() {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-
 	var res strings.Builder
 
 	res.WriteString("{")
-	for i, k := range keys {
+	// We want the output to be consistent, so we sort the output by key
+	for i, k := range sortedMapKeys(m.elements) {
 		if i != 0 {
 			res.WriteString(",")
 		}
-		res.WriteString(fmt.Sprintf("%q:%s", k, m.Elements()[k].String()))
+		res.WriteString(fmt.Sprintf("%q:%s", k, m.elements[k].String()))
 	}
 	res.WriteString("}")
 
@@ -328,3 +415,18 @@ func (m MapValue) String() string {
 func (m MapValue) ToMapValue(context.Context) (MapValue, diag.Diagnostics) {
 	return m, nil
 }
+
+// sortedMapKeys returns the keys of the given map elements in sorted order,
+// so that MapValue serialization and string representations are
+// deterministic.
+func sortedMapKeys(elements map[string]attr.Value) []string {
+	keys := make([]string, 0, len(elements))
+
+	for key := range elements {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
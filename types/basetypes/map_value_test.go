@@ -668,6 +668,245 @@ func TestMapValueIsUnknown(t *testing.T) {
 	}
 }
 
+func TestMapValueIsNullOrEmpty(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    MapValue
+		expected bool
+	}{
+		"known-empty": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			expected: true,
+		},
+		"known-one-element": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{"test": NewStringValue("test")}),
+			expected: false,
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewMapUnknown(StringType{}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsNullOrEmpty()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         MapValue
+		other         MapValue
+		expected      MapValue
+		expectedDiags diag.Diagnostics
+	}{
+		"disjoint-keys": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"one": NewStringValue("input-one"),
+			}),
+			other: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"two": NewStringValue("other-two"),
+			}),
+			expected: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"one": NewStringValue("input-one"),
+				"two": NewStringValue("other-two"),
+			}),
+		},
+		"overlapping-keys": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"one": NewStringValue("input-one"),
+				"two": NewStringValue("input-two"),
+			}),
+			other: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"two":   NewStringValue("other-two"),
+				"three": NewStringValue("other-three"),
+			}),
+			expected: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"one":   NewStringValue("input-one"),
+				"two":   NewStringValue("other-two"),
+				"three": NewStringValue("other-three"),
+			}),
+		},
+		"input-null": {
+			input: NewMapNull(StringType{}),
+			other: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"two": NewStringValue("other-two"),
+			}),
+			expected: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"two": NewStringValue("other-two"),
+			}),
+		},
+		"other-null": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"one": NewStringValue("input-one"),
+			}),
+			other: NewMapNull(StringType{}),
+			expected: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"one": NewStringValue("input-one"),
+			}),
+		},
+		"both-null": {
+			input:    NewMapNull(StringType{}),
+			other:    NewMapNull(StringType{}),
+			expected: NewMapNull(StringType{}),
+		},
+		"input-unknown": {
+			input: NewMapUnknown(StringType{}),
+			other: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"two": NewStringValue("other-two"),
+			}),
+			expected: NewMapUnknown(StringType{}),
+		},
+		"other-unknown": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"one": NewStringValue("input-one"),
+			}),
+			other:    NewMapUnknown(StringType{}),
+			expected: NewMapUnknown(StringType{}),
+		},
+		"element-type-mismatch": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"one": NewStringValue("input-one"),
+			}),
+			other: NewMapValueMust(BoolType{}, map[string]attr.Value{
+				"two": NewBoolValue(true),
+			}),
+			expected: NewMapUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Merge Element Type",
+					"While merging Map values, an invalid element type was detected. "+
+						"Both Map values must use a matching element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Map Element Type: basetypes.StringType\n"+
+						"Other Map Element Type: basetypes.BoolType",
+				),
+			},
+		},
+		"input-zero-value": {
+			input: MapValue{},
+			other: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"two": NewStringValue("other-two"),
+			}),
+			expected: NewMapUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Merge Element Type",
+					"While merging Map values, a missing element type was detected. "+
+						"Map values must be created with an element type, such as with NewMapValue() or NewMapNull(), rather than as a zero-value Map. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Map Element Type: <nil>\n"+
+						"Other Map Element Type: basetypes.StringType",
+				),
+			},
+		},
+		"other-zero-value": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"one": NewStringValue("input-one"),
+			}),
+			other:    MapValue{},
+			expected: NewMapUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Merge Element Type",
+					"While merging Map values, a missing element type was detected. "+
+						"Map values must be created with an element type, such as with NewMapValue() or NewMapNull(), rather than as a zero-value Map. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Map Element Type: basetypes.StringType\n"+
+						"Other Map Element Type: <nil>",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Merge(context.Background(), testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueMerge_immutable(t *testing.T) {
+	t.Parallel()
+
+	value := NewMapValueMust(StringType{}, map[string]attr.Value{"test": NewStringValue("original")})
+	other := NewMapValueMust(StringType{}, map[string]attr.Value{"test": NewStringValue("other")})
+
+	_, _ = value.Merge(context.Background(), other)
+
+	if !value.Equal(NewMapValueMust(StringType{}, map[string]attr.Value{"test": NewStringValue("original")})) {
+		t.Fatal("unexpected Merge mutation")
+	}
+}
+
+func TestMapValue_deterministic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	keys := []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot"}
+
+	// Build the same map with elements inserted in forward and reverse order.
+	forwardElements := make(map[string]attr.Value, len(keys))
+	reverseElements := make(map[string]attr.Value, len(keys))
+
+	for i := 0; i < len(keys); i++ {
+		forwardElements[keys[i]] = NewStringValue(keys[i])
+		reverseElements[keys[len(keys)-1-i]] = NewStringValue(keys[len(keys)-1-i])
+	}
+
+	forward := NewMapValueMust(StringType{}, forwardElements)
+	reverse := NewMapValueMust(StringType{}, reverseElements)
+
+	if diff := cmp.Diff(forward.String(), reverse.String()); diff != "" {
+		t.Errorf("unexpected String difference: %s", diff)
+	}
+
+	forwardTerraformValue, err := forward.ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	reverseTerraformValue, err := reverse.ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(forwardTerraformValue, reverseTerraformValue); diff != "" {
+		t.Errorf("unexpected ToTerraformValue difference: %s", diff)
+	}
+}
+
 func TestMapValueString(t *testing.T) {
 	t.Parallel()
 
@@ -873,3 +1112,84 @@ func TestMapTypeValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestMapElementsAsAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input              MapValue
+		expected           map[string]string
+		expectedDiags      diag.Diagnostics
+		expectedDiagsFirst diag.Diagnostics
+	}{
+		"valid": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"alpha": NewStringValue("hello"),
+				"bravo": NewStringValue("world"),
+			}),
+			expected: map[string]string{"alpha": "hello", "bravo": "world"},
+		},
+		"invalid-multiple": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"alpha":   NewStringNull(),
+				"bravo":   NewStringValue("hello"),
+				"charlie": NewStringUnknown(),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtMapKey("alpha"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: [\"alpha\"]\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtMapKey("charlie"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: [\"charlie\"]\nTarget Type: string\nSuggested Type: basetypes.StringValue",
+				),
+			},
+			expectedDiagsFirst: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtMapKey("alpha"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: [\"alpha\"]\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got map[string]string
+
+			diags := testCase.input.ElementsAsAll(context.Background(), &got, false)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			// ElementsAs retains the existing behavior of returning only the
+			// first invalid element diagnostics.
+			var gotFirst map[string]string
+
+			diags = testCase.input.ElementsAs(context.Background(), &gotFirst, false)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagsFirst); diff != "" {
+				t.Errorf("unexpected ElementsAs diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
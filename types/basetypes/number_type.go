@@ -80,8 +80,7 @@ func (t NumberType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (a
 	return NewNumberValue(n), nil
 }
 
-// ValueType returns the Value type.
+// ValueType returns the Value type, as a null value of this type.
 func (t NumberType) ValueType(_ context.Context) attr.Value {
-	// This Value does not need to be valid.
-	return NumberValue{}
+	return NewNumberNull()
 }
@@ -95,6 +95,15 @@ func (n NumberValue) ToTerraformValue(_ context.Context) (tftypes.Value, error)
 			return tftypes.NewValue(tftypes.Number, nil), nil
 		}
 
+		// Terraform numbers cannot represent infinity.
+		if n.value.IsInf() {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), fmt.Errorf(
+				"Number value %v cannot be converted into a Terraform number, as infinite values are not supported; "+
+					"ensure the value is a finite number, such as by checking the result of the calculation creating it",
+				n.value,
+			)
+		}
+
 		if err := tftypes.ValidateValue(tftypes.Number, n.value); err != nil {
 			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
 		}
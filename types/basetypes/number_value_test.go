@@ -61,6 +61,46 @@ func TestNumberValueToTerraformValue(t *testing.T) {
 	}
 }
 
+func TestNumberValueToTerraformValue_infinite(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input         NumberValue
+		expectedError string
+	}{
+		"positive-infinity": {
+			input:         NewNumberValue(big.NewFloat(math.Inf(1))),
+			expectedError: "Number value +Inf cannot be converted into a Terraform number, as infinite values are not supported; ensure the value is a finite number, such as by checking the result of the calculation creating it",
+		},
+		"negative-infinity": {
+			input:         NewNumberValue(big.NewFloat(math.Inf(-1))),
+			expectedError: "Number value -Inf cannot be converted into a Terraform number, as infinite values are not supported; ensure the value is a finite number, such as by checking the result of the calculation creating it",
+		},
+	}
+
+	for name, testCase := range tests {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ToTerraformValue(context.Background())
+
+			if err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if got.IsKnown() {
+				t.Errorf("expected unknown value, got: %s", got)
+			}
+		})
+	}
+}
+
 func TestNumberValueEqual(t *testing.T) {
 	t.Parallel()
 
@@ -161,11 +161,9 @@ func (o ObjectType) String() string {
 	return res.String()
 }
 
-// ValueType returns the Value type.
+// ValueType returns the Value type, as a null value of this type.
 func (o ObjectType) ValueType(_ context.Context) attr.Value {
-	return ObjectValue{
-		attributeTypes: o.AttrTypes,
-	}
+	return NewObjectNull(o.AttrTypes)
 }
 
 // ValueFromObject returns an ObjectValuable type given an Object.
@@ -413,3 +413,47 @@ func TestObjectTypeString(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ObjectType
+		expected attr.Value
+	}{
+		"AttrTypes-empty": {
+			input:    ObjectType{AttrTypes: map[string]attr.Type{}},
+			expected: NewObjectNull(map[string]attr.Type{}),
+		},
+		"AttrTypes-String-Bool": {
+			input: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"string": StringType{},
+					"bool":   BoolType{},
+				},
+			},
+			expected: NewObjectNull(map[string]attr.Type{
+				"string": StringType{},
+				"bool":   BoolType{},
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueType(context.Background())
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if !got.Type(context.Background()).Equal(testCase.input) {
+				t.Errorf("Expected type %s, got type %s", testCase.input, got.Type(context.Background()))
+			}
+		})
+	}
+}
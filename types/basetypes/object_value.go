@@ -122,15 +122,56 @@ func NewObjectValue(attributeTypes map[string]attr.Type, attributes map[string]a
 	}, nil
 }
 
+// NewObjectValueInferred creates a Object with a known value, where the
+// attribute types are inferred from the Type of each attribute value. This is
+// a convenience for constructing objects, such as in testing, where the
+// attribute types would otherwise be repeated. Null and unknown attribute
+// values must be created with their intended type. Access the value via the
+// Object type Attributes or As methods.
+func NewObjectValueInferred(attributes map[string]attr.Value) (ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	attributeTypes := make(map[string]attr.Type, len(attributes))
+
+	for name, attribute := range attributes {
+		if attribute == nil {
+			diags.AddError(
+				"Invalid Object Attribute Value",
+				"While creating a Object value, a missing attribute value was detected. "+
+					"An attribute value is required to infer the attribute type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Object Attribute Name: %s", name),
+			)
+
+			continue
+		}
+
+		attributeTypes[name] = attribute.Type(ctx)
+	}
+
+	if diags.HasError() {
+		return NewObjectUnknown(attributeTypes), diags
+	}
+
+	return NewObjectValue(attributeTypes, attributes)
+}
+
 // NewObjectValueFrom creates a Object with a known value, using reflection rules.
 // The attributes must be a map of string attribute names to attribute values
 // which can convert into the given attribute type or a struct with tfsdk field
 // tags. Access the value via the Object type Elements or ElementsAs methods.
-func NewObjectValueFrom(ctx context.Context, attributeTypes map[string]attr.Type, attributes any) (ObjectValue, diag.Diagnostics) {
+//
+// Use ValueFromOption, such as WithEmptyAsNull, to modify the reflection
+// behavior.
+func NewObjectValueFrom(ctx context.Context, attributeTypes map[string]attr.Type, attributes any, opts ...ValueFromOption) (ObjectValue, diag.Diagnostics) {
 	attrValue, diags := reflect.FromValue(
 		ctx,
 		ObjectType{AttrTypes: attributeTypes},
 		attributes,
+		reflect.NewOptions(opts...),
 		path.Empty(),
 	)
 
@@ -220,7 +261,7 @@ func (o ObjectValue) As(ctx context.Context, target interface{}, opts ObjectAsOp
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
 				"Object Conversion Error",
-				"An unexpected error was encountered trying to convert object. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert object. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			),
 		}
 	}
@@ -254,6 +295,114 @@ func (o ObjectValue) AttributeTypes(_ context.Context) map[string]attr.Type {
 	return result
 }
 
+// WithAttributeTypes returns a new Object with the given attribute types,
+// which is useful for migrating prior object values across schema versions,
+// such as within resource UpgradeState logic.
+//
+// Existing attribute values are kept when their type is unchanged, otherwise
+// they are converted into the new attribute type when the underlying
+// Terraform type is compatible, such as switching between an Int64 and
+// Number type or to and from a custom type. Attributes which are not present
+// in the existing Object are set to null and attributes not present in the
+// new attribute types are removed. Null or unknown Objects remain null or
+// unknown respectively.
+func (o ObjectValue) WithAttributeTypes(ctx context.Context, attributeTypes map[string]attr.Type) (ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch o.state {
+	case attr.ValueStateNull:
+		return NewObjectNull(attributeTypes), nil
+	case attr.ValueStateUnknown:
+		return NewObjectUnknown(attributeTypes), nil
+	}
+
+	attributes := make(map[string]attr.Value, len(attributeTypes))
+
+	for name, attributeType := range attributeTypes {
+		tfType := attributeType.TerraformType(ctx)
+		attribute, ok := o.attributes[name]
+
+		if !ok {
+			nullValue, err := attributeType.ValueFromTerraform(ctx, tftypes.NewValue(tfType, nil))
+
+			if err != nil {
+				diags.AddError(
+					"Object Attribute Type Conversion Error",
+					"While converting a Object value to new attribute types, an unexpected error occurred creating a null attribute value. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						fmt.Sprintf("Object Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+						fmt.Sprintf("Error: %s", err),
+				)
+
+				continue
+			}
+
+			attributes[name] = nullValue
+
+			continue
+		}
+
+		if attributeType.Equal(attribute.Type(ctx)) {
+			attributes[name] = attribute
+
+			continue
+		}
+
+		tfValue, err := attribute.ToTerraformValue(ctx)
+
+		if err != nil {
+			diags.AddError(
+				"Object Attribute Type Conversion Error",
+				"While converting a Object value to new attribute types, an unexpected error occurred converting an existing attribute value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Object Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("Error: %s", err),
+			)
+
+			continue
+		}
+
+		switch {
+		case tfValue.IsNull():
+			tfValue = tftypes.NewValue(tfType, nil)
+		case !tfValue.IsKnown():
+			tfValue = tftypes.NewValue(tfType, tftypes.UnknownValue)
+		case !tfValue.Type().Equal(tfType):
+			diags.AddError(
+				"Incompatible Object Attribute Type",
+				"While converting a Object value to new attribute types, an existing attribute value could not be converted to the new attribute type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Object Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("Object Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+
+			continue
+		}
+
+		newAttribute, err := attributeType.ValueFromTerraform(ctx, tfValue)
+
+		if err != nil {
+			diags.AddError(
+				"Object Attribute Type Conversion Error",
+				"While converting a Object value to new attribute types, an unexpected error occurred converting an existing attribute value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Object Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("Error: %s", err),
+			)
+
+			continue
+		}
+
+		attributes[name] = newAttribute
+	}
+
+	if diags.HasError() {
+		return NewObjectUnknown(attributeTypes), diags
+	}
+
+	return NewObjectValue(attributeTypes, attributes)
+}
+
 // Type returns an ObjectType with the same attribute types as `o`.
 func (o ObjectValue) Type(ctx context.Context) attr.Type {
 	return ObjectType{AttrTypes: o.AttributeTypes(ctx)}
@@ -351,6 +500,76 @@ func (o ObjectValue) Equal(c attr.Value) bool {
 	return true
 }
 
+// EqualIgnoringUnknown returns true if the given ObjectValue is equal to the
+// Object, except an unknown value on either side, such as an attribute which
+// will be computed during apply, matches any value of the other side. Nested
+// object attributes are compared in the same manner, while other attribute
+// values are compared with their Equal method. This is intended for logic,
+// such as plan modifiers, which must determine whether an object has
+// meaningfully changed. Use Equal for strict comparisons.
+func (o ObjectValue) EqualIgnoringUnknown(other ObjectValue) bool {
+	if o.IsUnknown() || other.IsUnknown() {
+		return true
+	}
+
+	if o.state != other.state {
+		return false
+	}
+
+	if o.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if len(o.attributeTypes) != len(other.attributeTypes) {
+		return false
+	}
+
+	for name, oAttributeType := range o.attributeTypes {
+		otherAttributeType, ok := other.attributeTypes[name]
+
+		if !ok {
+			return false
+		}
+
+		if !oAttributeType.Equal(otherAttributeType) {
+			return false
+		}
+	}
+
+	if len(o.attributes) != len(other.attributes) {
+		return false
+	}
+
+	for name, oAttribute := range o.attributes {
+		otherAttribute, ok := other.attributes[name]
+
+		if !ok {
+			return false
+		}
+
+		if oAttribute.IsUnknown() || otherAttribute.IsUnknown() {
+			continue
+		}
+
+		oObject, oOk := oAttribute.(ObjectValue)
+		otherObject, otherOk := otherAttribute.(ObjectValue)
+
+		if oOk && otherOk {
+			if !oObject.EqualIgnoringUnknown(otherObject) {
+				return false
+			}
+
+			continue
+		}
+
+		if !oAttribute.Equal(otherAttribute) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // IsNull returns true if the Object represents a null value.
 func (o ObjectValue) IsNull() bool {
 	return o.state == attr.ValueStateNull
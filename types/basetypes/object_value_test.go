@@ -171,15 +171,182 @@ func TestNewObjectValue(t *testing.T) {
 	}
 }
 
+func TestNewObjectValueInferred(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes    map[string]attr.Value
+		expected      ObjectValue
+		expectedDiags diag.Diagnostics
+	}{
+		"no-attributes": {
+			attributes: map[string]attr.Value{},
+			expected:   NewObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}),
+		},
+		"mixed-attributes": {
+			attributes: map[string]attr.Value{
+				"bool":   NewBoolValue(true),
+				"int64":  NewInt64Value(123),
+				"list":   NewListValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+				"null":   NewStringNull(),
+				"object": NewObjectValueMust(map[string]attr.Type{"nested": StringType{}}, map[string]attr.Value{"nested": NewStringValue("test")}),
+				"string": NewStringValue("test"),
+			},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"bool":   BoolType{},
+					"int64":  Int64Type{},
+					"list":   ListType{ElemType: StringType{}},
+					"null":   StringType{},
+					"object": ObjectType{AttrTypes: map[string]attr.Type{"nested": StringType{}}},
+					"string": StringType{},
+				},
+				map[string]attr.Value{
+					"bool":   NewBoolValue(true),
+					"int64":  NewInt64Value(123),
+					"list":   NewListValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+					"null":   NewStringNull(),
+					"object": NewObjectValueMust(map[string]attr.Type{"nested": StringType{}}, map[string]attr.Value{"nested": NewStringValue("test")}),
+					"string": NewStringValue("test"),
+				},
+			),
+		},
+		"unknown-attribute": {
+			attributes: map[string]attr.Value{
+				"known":   NewStringValue("test"),
+				"unknown": NewListUnknown(Int64Type{}),
+			},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"known":   StringType{},
+					"unknown": ListType{ElemType: Int64Type{}},
+				},
+				map[string]attr.Value{
+					"known":   NewStringValue("test"),
+					"unknown": NewListUnknown(Int64Type{}),
+				},
+			),
+		},
+		"nil-attribute": {
+			attributes: map[string]attr.Value{
+				"known": NewStringValue("test"),
+				"nil":   nil,
+			},
+			expected: NewObjectUnknown(map[string]attr.Type{
+				"known": StringType{},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Object Attribute Value",
+					"While creating a Object value, a missing attribute value was detected. "+
+						"An attribute value is required to infer the attribute type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Object Attribute Name: nil",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewObjectValueInferred(testCase.attributes)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNewObjectValueFrom(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
 		attributeTypes map[string]attr.Type
 		attributes     any
+		opts           []ValueFromOption
 		expected       ObjectValue
 		expectedDiags  diag.Diagnostics
 	}{
+		"valid-empty-collections": {
+			attributeTypes: map[string]attr.Type{
+				"list": ListType{ElemType: StringType{}},
+				"map":  MapType{ElemType: StringType{}},
+			},
+			attributes: struct {
+				List []string          `tfsdk:"list"`
+				Map  map[string]string `tfsdk:"map"`
+			}{
+				List: []string{},
+				Map:  map[string]string{},
+			},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"list": ListType{ElemType: StringType{}},
+					"map":  MapType{ElemType: StringType{}},
+				},
+				map[string]attr.Value{
+					"list": NewListValueMust(StringType{}, []attr.Value{}),
+					"map":  NewMapValueMust(StringType{}, map[string]attr.Value{}),
+				},
+			),
+		},
+		"valid-empty-collections-WithEmptyAsNull": {
+			attributeTypes: map[string]attr.Type{
+				"list": ListType{ElemType: StringType{}},
+				"map":  MapType{ElemType: StringType{}},
+			},
+			attributes: struct {
+				List []string          `tfsdk:"list"`
+				Map  map[string]string `tfsdk:"map"`
+			}{
+				List: []string{},
+				Map:  map[string]string{},
+			},
+			opts: []ValueFromOption{WithEmptyAsNull(true)},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"list": ListType{ElemType: StringType{}},
+					"map":  MapType{ElemType: StringType{}},
+				},
+				map[string]attr.Value{
+					"list": NewListNull(StringType{}),
+					"map":  NewMapNull(StringType{}),
+				},
+			),
+		},
+		"valid-collections-WithEmptyAsNull": {
+			attributeTypes: map[string]attr.Type{
+				"list": ListType{ElemType: StringType{}},
+				"map":  MapType{ElemType: StringType{}},
+			},
+			attributes: struct {
+				List []string          `tfsdk:"list"`
+				Map  map[string]string `tfsdk:"map"`
+			}{
+				List: []string{"test"},
+				Map:  map[string]string{"key": "test"},
+			},
+			opts: []ValueFromOption{WithEmptyAsNull(true)},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"list": ListType{ElemType: StringType{}},
+					"map":  MapType{ElemType: StringType{}},
+				},
+				map[string]attr.Value{
+					"list": NewListValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+					"map":  NewMapValueMust(StringType{}, map[string]attr.Value{"key": NewStringValue("test")}),
+				},
+			),
+		},
 		"valid-*struct": {
 			attributeTypes: map[string]attr.Type{
 				"bool":   BoolType{},
@@ -291,6 +458,54 @@ func TestNewObjectValueFrom(t *testing.T) {
 				),
 			},
 		},
+		"invalid-missing-attributes": {
+			attributeTypes: map[string]attr.Type{
+				"string": StringType{},
+				"bool":   BoolType{},
+				"int64":  Int64Type{},
+			},
+			attributes: struct {
+				String StringValue `tfsdk:"string"`
+			}{},
+			expected: NewObjectUnknown(map[string]attr.Type{
+				"string": StringType{},
+				"bool":   BoolType{},
+				"int64":  Int64Type{},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from struct into an object. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Mismatch between struct and object type: Object defines fields not found in struct: bool and int64.\n"+
+						"Struct: struct { String basetypes.StringValue \"tfsdk:\\\"string\\\"\" }\n"+
+						"Object type: types.ObjectType[\"bool\":basetypes.BoolType, \"int64\":basetypes.Int64Type, \"string\":basetypes.StringType]",
+				),
+			},
+		},
+		"invalid-extra-struct-fields": {
+			attributeTypes: map[string]attr.Type{
+				"string": StringType{},
+			},
+			attributes: struct {
+				String StringValue `tfsdk:"string"`
+				Extra  StringValue `tfsdk:"extra"`
+				Other  BoolValue   `tfsdk:"other"`
+			}{},
+			expected: NewObjectUnknown(map[string]attr.Type{
+				"string": StringType{},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from struct into an object. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Mismatch between struct and object type: Struct defines fields not found in object: extra (Extra) and other (Other).\n"+
+						"Struct: struct { String basetypes.StringValue \"tfsdk:\\\"string\\\"\"; Extra basetypes.StringValue \"tfsdk:\\\"extra\\\"\"; Other basetypes.BoolValue \"tfsdk:\\\"other\\\"\" }\n"+
+						"Object type: types.ObjectType[\"string\":basetypes.StringType]",
+				),
+			},
+		},
 		"invalid-type": {
 			attributeTypes: map[string]attr.Type{
 				"string": StringType{},
@@ -318,7 +533,7 @@ func TestNewObjectValueFrom(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := NewObjectValueFrom(context.Background(), testCase.attributeTypes, testCase.attributes)
+			got, diags := NewObjectValueFrom(context.Background(), testCase.attributeTypes, testCase.attributes, testCase.opts...)
 
 			if diff := cmp.Diff(got, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
@@ -709,6 +924,142 @@ func TestObjectValueAttributeTypes_immutable(t *testing.T) {
 	}
 }
 
+func TestObjectValueWithAttributeTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input          ObjectValue
+		attributeTypes map[string]attr.Type
+		expected       ObjectValue
+		expectedDiags  diag.Diagnostics
+	}{
+		"null": {
+			input:          NewObjectNull(map[string]attr.Type{"test_attr": StringType{}}),
+			attributeTypes: map[string]attr.Type{"test_attr": BoolType{}},
+			expected:       NewObjectNull(map[string]attr.Type{"test_attr": BoolType{}}),
+		},
+		"unknown": {
+			input:          NewObjectUnknown(map[string]attr.Type{"test_attr": StringType{}}),
+			attributeTypes: map[string]attr.Type{"test_attr": BoolType{}},
+			expected:       NewObjectUnknown(map[string]attr.Type{"test_attr": BoolType{}}),
+		},
+		"unchanged": {
+			input: NewObjectValueMust(
+				map[string]attr.Type{"test_attr": StringType{}},
+				map[string]attr.Value{"test_attr": NewStringValue("test-value")},
+			),
+			attributeTypes: map[string]attr.Type{"test_attr": StringType{}},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{"test_attr": StringType{}},
+				map[string]attr.Value{"test_attr": NewStringValue("test-value")},
+			),
+		},
+		"attribute-added": {
+			input: NewObjectValueMust(
+				map[string]attr.Type{"test_attr": StringType{}},
+				map[string]attr.Value{"test_attr": NewStringValue("test-value")},
+			),
+			attributeTypes: map[string]attr.Type{
+				"test_attr":  StringType{},
+				"test_added": ListType{ElemType: StringType{}},
+			},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"test_attr":  StringType{},
+					"test_added": ListType{ElemType: StringType{}},
+				},
+				map[string]attr.Value{
+					"test_attr":  NewStringValue("test-value"),
+					"test_added": NewListNull(StringType{}),
+				},
+			),
+		},
+		"attribute-removed": {
+			input: NewObjectValueMust(
+				map[string]attr.Type{
+					"test_attr":    StringType{},
+					"test_removed": BoolType{},
+				},
+				map[string]attr.Value{
+					"test_attr":    NewStringValue("test-value"),
+					"test_removed": NewBoolValue(true),
+				},
+			),
+			attributeTypes: map[string]attr.Type{"test_attr": StringType{}},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{"test_attr": StringType{}},
+				map[string]attr.Value{"test_attr": NewStringValue("test-value")},
+			),
+		},
+		"attribute-type-changed": {
+			input: NewObjectValueMust(
+				map[string]attr.Type{
+					"test_known":   Int64Type{},
+					"test_null":    StringType{},
+					"test_unknown": StringType{},
+				},
+				map[string]attr.Value{
+					"test_known":   NewInt64Value(123),
+					"test_null":    NewStringNull(),
+					"test_unknown": NewStringUnknown(),
+				},
+			),
+			attributeTypes: map[string]attr.Type{
+				"test_known":   NumberType{},
+				"test_null":    BoolType{},
+				"test_unknown": BoolType{},
+			},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"test_known":   NumberType{},
+					"test_null":    BoolType{},
+					"test_unknown": BoolType{},
+				},
+				map[string]attr.Value{
+					"test_known":   NewNumberValue(big.NewFloat(123)),
+					"test_null":    NewBoolNull(),
+					"test_unknown": NewBoolUnknown(),
+				},
+			),
+		},
+		"attribute-type-changed-incompatible": {
+			input: NewObjectValueMust(
+				map[string]attr.Type{"test_attr": StringType{}},
+				map[string]attr.Value{"test_attr": NewStringValue("test-value")},
+			),
+			attributeTypes: map[string]attr.Type{"test_attr": BoolType{}},
+			expected:       NewObjectUnknown(map[string]attr.Type{"test_attr": BoolType{}}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Incompatible Object Attribute Type",
+					"While converting a Object value to new attribute types, an existing attribute value could not be converted to the new attribute type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Object Attribute Name (test_attr) Expected Type: basetypes.BoolType\n"+
+						"Object Attribute Name (test_attr) Given Type: basetypes.StringType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.WithAttributeTypes(context.Background(), testCase.attributeTypes)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestObjectValueToTerraformValue(t *testing.T) {
 	t.Parallel()
 	type testCase struct {
@@ -1492,6 +1843,231 @@ func TestObjectValueEqual(t *testing.T) {
 	}
 }
 
+func TestObjectValueEqualIgnoringUnknown(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"string": StringType{},
+		"bool":   BoolType{},
+		"nested": ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"computed": StringType{},
+			},
+		},
+	}
+	nestedAttributeTypes := map[string]attr.Type{
+		"computed": StringType{},
+	}
+
+	type testCase struct {
+		receiver ObjectValue
+		arg      ObjectValue
+		expected bool
+	}
+	tests := map[string]testCase{
+		"known-known-equal": {
+			receiver: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectValueMust(nestedAttributeTypes, map[string]attr.Value{
+						"computed": NewStringValue("computed"),
+					}),
+				},
+			),
+			arg: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectValueMust(nestedAttributeTypes, map[string]attr.Value{
+						"computed": NewStringValue("computed"),
+					}),
+				},
+			),
+			expected: true,
+		},
+		"known-known-different": {
+			receiver: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectNull(nestedAttributeTypes),
+				},
+			),
+			arg: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("other"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectNull(nestedAttributeTypes),
+				},
+			),
+			expected: false,
+		},
+		"receiver-attribute-unknown": {
+			receiver: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringUnknown(),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectNull(nestedAttributeTypes),
+				},
+			),
+			arg: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectNull(nestedAttributeTypes),
+				},
+			),
+			expected: true,
+		},
+		"arg-attribute-unknown": {
+			receiver: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectNull(nestedAttributeTypes),
+				},
+			),
+			arg: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolUnknown(),
+					"nested": NewObjectNull(nestedAttributeTypes),
+				},
+			),
+			expected: true,
+		},
+		"attribute-unknown-other-attribute-different": {
+			receiver: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringUnknown(),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectNull(nestedAttributeTypes),
+				},
+			),
+			arg: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(false),
+					"nested": NewObjectNull(nestedAttributeTypes),
+				},
+			),
+			expected: false,
+		},
+		"nested-attribute-unknown": {
+			receiver: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectValueMust(nestedAttributeTypes, map[string]attr.Value{
+						"computed": NewStringUnknown(),
+					}),
+				},
+			),
+			arg: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectValueMust(nestedAttributeTypes, map[string]attr.Value{
+						"computed": NewStringValue("computed"),
+					}),
+				},
+			),
+			expected: true,
+		},
+		"nested-attribute-different": {
+			receiver: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectValueMust(nestedAttributeTypes, map[string]attr.Value{
+						"computed": NewStringValue("one"),
+					}),
+				},
+			),
+			arg: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectValueMust(nestedAttributeTypes, map[string]attr.Value{
+						"computed": NewStringValue("two"),
+					}),
+				},
+			),
+			expected: false,
+		},
+		"unknown-known": {
+			receiver: NewObjectUnknown(attributeTypes),
+			arg: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectNull(nestedAttributeTypes),
+				},
+			),
+			expected: true,
+		},
+		"null-known": {
+			receiver: NewObjectNull(attributeTypes),
+			arg: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"string": NewStringValue("test"),
+					"bool":   NewBoolValue(true),
+					"nested": NewObjectNull(nestedAttributeTypes),
+				},
+			),
+			expected: false,
+		},
+		"null-null": {
+			receiver: NewObjectNull(attributeTypes),
+			arg:      NewObjectNull(attributeTypes),
+			expected: true,
+		},
+		"different-attribute-types": {
+			receiver: NewObjectValueMust(
+				map[string]attr.Type{"string": StringType{}},
+				map[string]attr.Value{"string": NewStringUnknown()},
+			),
+			arg: NewObjectValueMust(
+				map[string]attr.Type{"bool": BoolType{}},
+				map[string]attr.Value{"bool": NewBoolValue(true)},
+			),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.receiver.EqualIgnoringUnknown(test.arg)
+			if got != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+
+			if test.receiver.Equal(test.arg) && !got {
+				t.Errorf("Expected EqualIgnoringUnknown to be true when Equal is true")
+			}
+		})
+	}
+}
+
 func TestObjectValueIsNull(t *testing.T) {
 	t.Parallel()
 
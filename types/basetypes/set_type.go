@@ -140,7 +140,7 @@ func (st SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 		diags.AddAttributeError(
 			path,
 			"Set Type Validation Error",
-			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return diags
 	}
@@ -155,7 +155,7 @@ func (st SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 		diags.AddAttributeError(
 			path,
 			"Set Type Validation Error",
-			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 		)
 		return diags
 	}
@@ -178,7 +178,7 @@ func (st SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 				diags.AddAttributeError(
 					path,
 					"Set Type Validation Error",
-					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 				)
 				return diags
 			}
@@ -206,11 +206,9 @@ func (st SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 	return diags
 }
 
-// ValueType returns the Value type.
+// ValueType returns the Value type, as a null value of this type.
 func (st SetType) ValueType(_ context.Context) attr.Value {
-	return SetValue{
-		elementType: st.ElementType(),
-	}
+	return NewSetNull(st.ElementType())
 }
 
 // ValueFromSet returns a SetValuable type given a Set.
@@ -362,3 +362,39 @@ func TestSetTypeString(t *testing.T) {
 		})
 	}
 }
+
+func TestSetTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetType
+		expected attr.Value
+	}{
+		"ElemType-String": {
+			input:    SetType{ElemType: StringType{}},
+			expected: NewSetNull(StringType{}),
+		},
+		"ElemType-List-Int64": {
+			input:    SetType{ElemType: ListType{ElemType: Int64Type{}}},
+			expected: NewSetNull(ListType{ElemType: Int64Type{}}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueType(context.Background())
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if !got.Type(context.Background()).Equal(testCase.input) {
+				t.Errorf("Expected type %s, got type %s", testCase.input, got.Type(context.Background()))
+			}
+		})
+	}
+}
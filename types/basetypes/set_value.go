@@ -6,6 +6,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -88,19 +89,86 @@ func NewSetValue(elementType attr.Type, elements []attr.Value) (SetValue, diag.D
 
 	return SetValue{
 		elementType: elementType,
-		elements:    elements,
+		elements:    sortedSetElements(ctx, elements),
 		state:       attr.ValueStateKnown,
 	}, nil
 }
 
+// sortedSetElements returns a copy of the given elements in canonical order,
+// as defined by setElementsOrder. If any element cannot be converted into a
+// Terraform value, the elements are returned in the given order.
+func sortedSetElements(ctx context.Context, elements []attr.Value) []attr.Value {
+	if elements == nil {
+		return nil
+	}
+
+	tfValues := make([]tftypes.Value, 0, len(elements))
+
+	for _, element := range elements {
+		tfValue, err := element.ToTerraformValue(ctx)
+
+		if err != nil {
+			return append([]attr.Value{}, elements...)
+		}
+
+		tfValues = append(tfValues, tfValue)
+	}
+
+	result := make([]attr.Value, 0, len(elements))
+
+	for _, idx := range setElementsOrder(tfValues) {
+		result = append(result, elements[idx])
+	}
+
+	return result
+}
+
+// setElementsOrder returns the indices of the given set element Terraform
+// values in canonical order, which is the byte order of their MessagePack
+// encoding. MessagePack is the precise encoding Terraform uses for values,
+// unlike String() representations which may be lossy, such as for numbers.
+// Elements which cannot be encoded are ordered last, in their given order.
+func setElementsOrder(values []tftypes.Value) []int {
+	keys := make([]string, len(values))
+	encoded := make([]bool, len(values))
+	indices := make([]int, len(values))
+
+	for idx, value := range values {
+		indices[idx] = idx
+
+		key, err := value.MarshalMsgPack(value.Type())
+
+		if err != nil {
+			continue
+		}
+
+		keys[idx] = string(key)
+		encoded[idx] = true
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		if encoded[indices[i]] != encoded[indices[j]] {
+			return encoded[indices[i]]
+		}
+
+		return keys[indices[i]] < keys[indices[j]]
+	})
+
+	return indices
+}
+
 // NewSetValueFrom creates a Set with a known value, using reflection rules.
 // The elements must be a slice which can convert into the given element type.
 // Access the value via the Set type Elements or ElementsAs methods.
-func NewSetValueFrom(ctx context.Context, elementType attr.Type, elements any) (SetValue, diag.Diagnostics) {
+//
+// Use ValueFromOption, such as WithEmptyAsNull, to modify the reflection
+// behavior.
+func NewSetValueFrom(ctx context.Context, elementType attr.Type, elements any, opts ...ValueFromOption) (SetValue, diag.Diagnostics) {
 	attrValue, diags := reflect.FromValue(
 		ctx,
 		SetType{ElemType: elementType},
 		elements,
+		reflect.NewOptions(opts...),
 		path.Empty(),
 	)
 
@@ -152,6 +220,11 @@ func NewSetValueMust(elementType attr.Type, elements []attr.Value) SetValue {
 
 // SetValue represents a set of attr.Value, all of the same type,
 // indicated by ElemType.
+//
+// Known elements are stored in a canonical order, sorted by the MessagePack
+// encoding of each element Terraform value, regardless of the order in which
+// they were given. This ensures that Elements, ElementsAs, String, and
+// ToTerraformValue return deterministic results for equal sets.
 type SetValue struct {
 	// elements is the collection of known values in the Set.
 	elements []attr.Value
@@ -176,6 +249,19 @@ func (s SetValue) Elements() []attr.Value {
 // ElementsAs populates `target` with the elements of the SetValue, throwing an
 // error if the elements cannot be stored in `target`.
 func (s SetValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
+	return s.elementsAs(ctx, target, allowUnhandled, false)
+}
+
+// ElementsAsAll populates `target` with the elements of the SetValue, like
+// ElementsAs, except every element is converted even after an element cannot
+// be stored in `target`, so the diagnostics for all invalid elements are
+// returned with their element paths. If any element is invalid, `target` is
+// not modified.
+func (s SetValue) ElementsAsAll(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
+	return s.elementsAs(ctx, target, allowUnhandled, true)
+}
+
+func (s SetValue) elementsAs(ctx context.Context, target interface{}, allowUnhandled bool, collectAllElementErrors bool) diag.Diagnostics {
 	// we need a tftypes.Value for this Set to be able to use it with our
 	// reflection code
 	val, err := s.ToTerraformValue(ctx)
@@ -183,11 +269,12 @@ func (s SetValue) ElementsAs(ctx context.Context, target interface{}, allowUnhan
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
 				"Set Element Conversion Error",
-				"An unexpected error was encountered trying to convert set elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				"An unexpected error was encountered trying to convert set elements. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+err.Error(),
 			),
 		}
 	}
 	return reflect.Into(ctx, s.Type(ctx), val, target, reflect.Options{
+		CollectAllElementErrors: collectAllElementErrors,
 		UnhandledNullAsEmpty:    allowUnhandled,
 		UnhandledUnknownAsEmpty: allowUnhandled,
 	}, path.Empty())
@@ -204,6 +291,8 @@ func (s SetValue) Type(ctx context.Context) attr.Type {
 }
 
 // ToTerraformValue returns the data contained in the Set as a tftypes.Value.
+// Known elements are ordered by their Terraform value, so equal sets always
+// return identical values regardless of the order elements were given.
 func (s SetValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	setType := tftypes.Set{ElementType: s.ElementType(ctx).TerraformType(ctx)}
 
@@ -221,6 +310,17 @@ func (s SetValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 			vals = append(vals, val)
 		}
 
+		// Elements are already stored in canonical order, however this
+		// ensures equal sets always produce identical Terraform values, even
+		// if an element could not be ordered when the set was created.
+		orderedVals := make([]tftypes.Value, 0, len(vals))
+
+		for _, idx := range setElementsOrder(vals) {
+			orderedVals = append(orderedVals, vals[idx])
+		}
+
+		vals = orderedVals
+
 		if err := tftypes.ValidateValue(setType, vals); err != nil {
 			return tftypes.NewValue(setType, tftypes.UnknownValue), err
 		}
@@ -270,6 +370,20 @@ func (s SetValue) Equal(o attr.Value) bool {
 	return true
 }
 
+// ContainsAll returns true if every candidate is equal to an element of the
+// Set, as defined by the Equal method of the element. An empty candidates
+// slice always returns true. Null and unknown Sets return false for any
+// candidates, as they have no known elements.
+func (s SetValue) ContainsAll(_ context.Context, candidates []attr.Value) bool {
+	for _, candidate := range candidates {
+		if !s.contains(candidate) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (s SetValue) contains(v attr.Value) bool {
 	for _, elem := range s.Elements() {
 		if elem.Equal(v) {
@@ -292,6 +406,17 @@ func (s SetValue) IsUnknown() bool {
 	return s.state == attr.ValueStateUnknown
 }
 
+// IsNullOrEmpty returns true if the Set represents a null value or a known
+// value without elements. Returns false if the Set represents a currently
+// unknown value, as whether it will contain elements cannot be determined.
+func (s SetValue) IsNullOrEmpty() bool {
+	if s.IsNull() {
+		return true
+	}
+
+	return !s.IsUnknown() && len(s.elements) == 0
+}
+
 // String returns a human-readable representation of the Set value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
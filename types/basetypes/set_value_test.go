@@ -5,6 +5,7 @@ package basetypes
 
 import (
 	"context"
+	"math/big"
 	"strconv"
 	"testing"
 
@@ -587,6 +588,76 @@ func TestSetValueToTerraformValue(t *testing.T) {
 	}
 }
 
+func TestSetValueToTerraformValue_order(t *testing.T) {
+	t.Parallel()
+
+	objectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  StringType{},
+			"count": Int64Type{},
+		},
+	}
+
+	objectValue := func(name string, count int64) attr.Value {
+		return NewObjectValueMust(
+			objectType.AttrTypes,
+			map[string]attr.Value{
+				"name":  NewStringValue(name),
+				"count": NewInt64Value(count),
+			},
+		)
+	}
+
+	elements := []attr.Value{
+		objectValue("b", 2),
+		objectValue("a", 10),
+		objectValue("a", 1),
+		objectValue("c", 3),
+	}
+
+	testCases := map[string][]int{
+		"reversed": {3, 2, 1, 0},
+		"shuffled": {2, 0, 3, 1},
+		"rotated":  {1, 2, 3, 0},
+	}
+
+	ctx := context.Background()
+
+	expected, err := NewSetValueMust(objectType, elements).ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for name, order := range testCases {
+		name, order := name, order
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			orderedElements := make([]attr.Value, 0, len(order))
+
+			for _, idx := range order {
+				orderedElements = append(orderedElements, elements[idx])
+			}
+
+			got, err := NewSetValueMust(objectType, orderedElements).ToTerraformValue(ctx)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if got.String() != expected.String() {
+				t.Errorf("expected identical serialization, got: %s, expected: %s", got, expected)
+			}
+		})
+	}
+}
+
 func TestSetValueElements(t *testing.T) {
 	t.Parallel()
 
@@ -634,6 +705,90 @@ func TestSetValueElements_immutable(t *testing.T) {
 	}
 }
 
+func TestSetValueElements_insertionOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	first := NewSetValueMust(StringType{}, []attr.Value{
+		NewStringValue("hello"),
+		NewStringValue("world"),
+		NewStringValue("foo"),
+	})
+	second := NewSetValueMust(StringType{}, []attr.Value{
+		NewStringValue("foo"),
+		NewStringValue("world"),
+		NewStringValue("hello"),
+	})
+
+	if diff := cmp.Diff(first.Elements(), second.Elements()); diff != "" {
+		t.Errorf("unexpected Elements difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(first.String(), second.String()); diff != "" {
+		t.Errorf("unexpected String difference: %s", diff)
+	}
+
+	firstTerraform, err := first.ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	secondTerraform, err := second.ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(firstTerraform, secondTerraform); diff != "" {
+		t.Errorf("unexpected ToTerraformValue difference: %s", diff)
+	}
+
+	if !first.Equal(second) {
+		t.Error("expected sets with different insertion orders to be equal")
+	}
+}
+
+func TestSetValueElements_numberOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// These numbers have the same lossy String() representation.
+	smaller, _, _ := big.ParseFloat("1.0000000000000000001", 10, 512, big.ToNearestEven)
+	larger, _, _ := big.ParseFloat("1.0000000000000000002", 10, 512, big.ToNearestEven)
+
+	first := NewSetValueMust(NumberType{}, []attr.Value{
+		NewNumberValue(larger),
+		NewNumberValue(smaller),
+	})
+	second := NewSetValueMust(NumberType{}, []attr.Value{
+		NewNumberValue(smaller),
+		NewNumberValue(larger),
+	})
+
+	if diff := cmp.Diff(first.Elements(), second.Elements()); diff != "" {
+		t.Errorf("unexpected Elements difference: %s", diff)
+	}
+
+	firstTerraform, err := first.ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	secondTerraform, err := second.ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(firstTerraform, secondTerraform); diff != "" {
+		t.Errorf("unexpected ToTerraformValue difference: %s", diff)
+	}
+}
+
 func TestSetValueElementType(t *testing.T) {
 	t.Parallel()
 
@@ -918,6 +1073,129 @@ func TestSetValueIsUnknown(t *testing.T) {
 	}
 }
 
+func TestSetValueContainsAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input      SetValue
+		candidates []attr.Value
+		expected   bool
+	}{
+		"all-present": {
+			input: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("first"),
+				NewStringValue("second"),
+				NewStringValue("third"),
+			}),
+			candidates: []attr.Value{
+				NewStringValue("third"),
+				NewStringValue("first"),
+			},
+			expected: true,
+		},
+		"some-missing": {
+			input: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("first"),
+				NewStringValue("second"),
+			}),
+			candidates: []attr.Value{
+				NewStringValue("first"),
+				NewStringValue("missing"),
+			},
+			expected: false,
+		},
+		"different-type": {
+			input: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("1"),
+			}),
+			candidates: []attr.Value{
+				NewInt64Value(1),
+			},
+			expected: false,
+		},
+		"empty-candidates": {
+			input: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("first"),
+			}),
+			candidates: []attr.Value{},
+			expected:   true,
+		},
+		"nil-candidates": {
+			input:      NewSetValueMust(StringType{}, []attr.Value{}),
+			candidates: nil,
+			expected:   true,
+		},
+		"null": {
+			input: NewSetNull(StringType{}),
+			candidates: []attr.Value{
+				NewStringValue("first"),
+			},
+			expected: false,
+		},
+		"unknown": {
+			input: NewSetUnknown(StringType{}),
+			candidates: []attr.Value{
+				NewStringValue("first"),
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ContainsAll(context.Background(), testCase.candidates)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueIsNullOrEmpty(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetValue
+		expected bool
+	}{
+		"known-empty": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			expected: true,
+		},
+		"known-one-element": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			expected: false,
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsNullOrEmpty()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSetValueString(t *testing.T) {
 	t.Parallel()
 
@@ -958,7 +1236,7 @@ func TestSetValueString(t *testing.T) {
 					),
 				},
 			),
-			expectation: `[["hello","world"],["foo","bar"]]`,
+			expectation: `[["bar","foo"],["hello","world"]]`,
 		},
 		"unknown": {
 			input:       NewSetUnknown(StringType{}),
@@ -1055,3 +1333,84 @@ func TestSetValueType(t *testing.T) {
 		})
 	}
 }
+
+func TestSetElementsAsAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input              SetValue
+		expected           []string
+		expectedDiags      diag.Diagnostics
+		expectedDiagsFirst diag.Diagnostics
+	}{
+		"valid": {
+			input: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("hello"),
+				NewStringValue("world"),
+			}),
+			expected: []string{"hello", "world"},
+		},
+		"invalid-multiple": {
+			input: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringNull(),
+				NewStringValue("hello"),
+				NewStringUnknown(),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtSetValue(NewStringNull()),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: [Value(<null>)]\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtSetValue(NewStringUnknown()),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: [Value(<unknown>)]\nTarget Type: string\nSuggested Type: basetypes.StringValue",
+				),
+			},
+			expectedDiagsFirst: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtSetValue(NewStringNull()),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: [Value(<null>)]\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			diags := testCase.input.ElementsAsAll(context.Background(), &got, false)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			// ElementsAs retains the existing behavior of returning only the
+			// first invalid element diagnostics.
+			var gotFirst []string
+
+			diags = testCase.input.ElementsAs(context.Background(), &gotFirst, false)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagsFirst); diff != "" {
+				t.Errorf("unexpected ElementsAs diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
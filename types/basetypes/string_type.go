@@ -79,8 +79,7 @@ func (t StringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (a
 	return NewStringValue(s), nil
 }
 
-// ValueType returns the Value type.
+// ValueType returns the Value type, as a null value of this type.
 func (t StringType) ValueType(_ context.Context) attr.Value {
-	// This Value does not need to be valid.
-	return StringValue{}
+	return NewStringNull()
 }
@@ -6,6 +6,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -181,6 +182,34 @@ func (s StringValue) ValueStringPointer() *string {
 	return &s.value
 }
 
+// ToLower returns a new String with all Unicode letters of the known value
+// mapped to their lower case. Null and unknown values are returned unchanged.
+func (s StringValue) ToLower() StringValue {
+	return s.transform(strings.ToLower)
+}
+
+// ToUpper returns a new String with all Unicode letters of the known value
+// mapped to their upper case. Null and unknown values are returned unchanged.
+func (s StringValue) ToUpper() StringValue {
+	return s.transform(strings.ToUpper)
+}
+
+// TrimSpace returns a new String with all leading and trailing white space
+// of the known value removed. Null and unknown values are returned unchanged.
+func (s StringValue) TrimSpace() StringValue {
+	return s.transform(strings.TrimSpace)
+}
+
+// transform returns a new known String with the given function applied to
+// the known value, or the String unchanged if it is null or unknown.
+func (s StringValue) transform(f func(string) string) StringValue {
+	if s.state != attr.ValueStateKnown {
+		return s
+	}
+
+	return NewStringValue(f(s.value))
+}
+
 // ToStringValue returns String.
 func (s StringValue) ToStringValue(context.Context) (StringValue, diag.Diagnostics) {
 	return s, nil
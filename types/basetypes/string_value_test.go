@@ -315,6 +315,132 @@ func TestStringValueValueStringPointer(t *testing.T) {
 	}
 }
 
+func TestStringValueToLower(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    StringValue
+		expected StringValue
+	}{
+		"known": {
+			input:    NewStringValue("Test Value"),
+			expected: NewStringValue("test value"),
+		},
+		"null": {
+			input:    NewStringNull(),
+			expected: NewStringNull(),
+		},
+		"unknown": {
+			input:    NewStringUnknown(),
+			expected: NewStringUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			original := testCase.input
+
+			got := testCase.input.ToLower()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.input, original); diff != "" {
+				t.Errorf("unexpected receiver modification: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringValueToUpper(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    StringValue
+		expected StringValue
+	}{
+		"known": {
+			input:    NewStringValue("Test Value"),
+			expected: NewStringValue("TEST VALUE"),
+		},
+		"null": {
+			input:    NewStringNull(),
+			expected: NewStringNull(),
+		},
+		"unknown": {
+			input:    NewStringUnknown(),
+			expected: NewStringUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			original := testCase.input
+
+			got := testCase.input.ToUpper()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.input, original); diff != "" {
+				t.Errorf("unexpected receiver modification: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringValueTrimSpace(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    StringValue
+		expected StringValue
+	}{
+		"known": {
+			input:    NewStringValue(" \ttest value\n "),
+			expected: NewStringValue("test value"),
+		},
+		"null": {
+			input:    NewStringNull(),
+			expected: NewStringNull(),
+		},
+		"unknown": {
+			input:    NewStringUnknown(),
+			expected: NewStringUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			original := testCase.input
+
+			got := testCase.input.TrimSpace()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.input, original); diff != "" {
+				t.Errorf("unexpected receiver modification: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNewStringPointerValue(t *testing.T) {
 	t.Parallel()
 
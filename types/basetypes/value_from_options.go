@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+// ValueFromOption is a function which modifies the behavior of reflection-based
+// value creation, such as NewListValueFrom and NewObjectValueFrom. Use the
+// option functions, such as WithEmptyAsNull, to create options.
+type ValueFromOption func(*reflect.Options)
+
+// WithEmptyAsNull returns a ValueFromOption which sets whether empty Go slices
+// and maps, including those nested within structs, are converted into null
+// collection values instead of empty collection values. This can prevent
+// differences between an unconfigured optional attribute and an empty
+// collection returned by an API.
+func WithEmptyAsNull(emptyAsNull bool) ValueFromOption {
+	return func(o *reflect.Options) {
+		o.EmptyAsNull = emptyAsNull
+	}
+}
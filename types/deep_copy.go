@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// DeepCopy returns an independent copy of the given value, including any
+// underlying values of lists, maps, objects, and sets. Modifying data
+// referenced by the original value, such as the *big.Float of a Number
+// value, does not affect the copy. Any value which can be created, including
+// non-finite Float64 and Number values, can be copied.
+//
+// Custom value types are copied through their basetypes Valuable interface,
+// such as basetypes.NumberValuable, and converted back using the
+// corresponding Typable interface of their type, such as
+// basetypes.NumberTypable. Those conversions accept a context and may return
+// diagnostics, which are returned here. Other value types are copied using
+// their type's ValueFromTerraform method.
+//
+// A nil value returns nil.
+func DeepCopy(ctx context.Context, v attr.Value) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v == nil {
+		return nil, diags
+	}
+
+	// Null and unknown values do not reference any underlying data.
+	if v.IsNull() || v.IsUnknown() {
+		return v, diags
+	}
+
+	switch value := v.(type) {
+	// Values of these types do not reference any mutable data.
+	case basetypes.BoolValuable, basetypes.Float64Valuable, basetypes.Int64Valuable, basetypes.StringValuable:
+		return v, diags
+	case basetypes.NumberValuable:
+		typable, ok := v.Type(ctx).(basetypes.NumberTypable)
+
+		if !ok {
+			diags.Append(deepCopyTypableDiagnostic(ctx, v, "basetypes.NumberTypable"))
+
+			return nil, diags
+		}
+
+		numberValue, numberDiags := value.ToNumberValue(ctx)
+
+		diags.Append(numberDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, resultDiags := typable.ValueFromNumber(ctx, basetypes.NewNumberValue(new(big.Float).Copy(numberValue.ValueBigFloat())))
+
+		diags.Append(resultDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return result, diags
+	case basetypes.ListValuable:
+		typable, ok := v.Type(ctx).(basetypes.ListTypable)
+
+		if !ok {
+			diags.Append(deepCopyTypableDiagnostic(ctx, v, "basetypes.ListTypable"))
+
+			return nil, diags
+		}
+
+		listValue, listDiags := value.ToListValue(ctx)
+
+		diags.Append(listDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		elements, elementsDiags := deepCopyElements(ctx, listValue.Elements())
+
+		diags.Append(elementsDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		listValue, listDiags = basetypes.NewListValue(listValue.ElementType(ctx), elements)
+
+		diags.Append(listDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, resultDiags := typable.ValueFromList(ctx, listValue)
+
+		diags.Append(resultDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return result, diags
+	case basetypes.MapValuable:
+		typable, ok := v.Type(ctx).(basetypes.MapTypable)
+
+		if !ok {
+			diags.Append(deepCopyTypableDiagnostic(ctx, v, "basetypes.MapTypable"))
+
+			return nil, diags
+		}
+
+		mapValue, mapDiags := value.ToMapValue(ctx)
+
+		diags.Append(mapDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		elements, elementsDiags := deepCopyAttributes(ctx, mapValue.Elements())
+
+		diags.Append(elementsDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		mapValue, mapDiags = basetypes.NewMapValue(mapValue.ElementType(ctx), elements)
+
+		diags.Append(mapDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, resultDiags := typable.ValueFromMap(ctx, mapValue)
+
+		diags.Append(resultDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return result, diags
+	case basetypes.ObjectValuable:
+		typable, ok := v.Type(ctx).(basetypes.ObjectTypable)
+
+		if !ok {
+			diags.Append(deepCopyTypableDiagnostic(ctx, v, "basetypes.ObjectTypable"))
+
+			return nil, diags
+		}
+
+		objectValue, objectDiags := value.ToObjectValue(ctx)
+
+		diags.Append(objectDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		attributes, attributesDiags := deepCopyAttributes(ctx, objectValue.Attributes())
+
+		diags.Append(attributesDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		objectValue, objectDiags = basetypes.NewObjectValue(objectValue.AttributeTypes(ctx), attributes)
+
+		diags.Append(objectDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, resultDiags := typable.ValueFromObject(ctx, objectValue)
+
+		diags.Append(resultDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return result, diags
+	case basetypes.SetValuable:
+		typable, ok := v.Type(ctx).(basetypes.SetTypable)
+
+		if !ok {
+			diags.Append(deepCopyTypableDiagnostic(ctx, v, "basetypes.SetTypable"))
+
+			return nil, diags
+		}
+
+		setValue, setDiags := value.ToSetValue(ctx)
+
+		diags.Append(setDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		elements, elementsDiags := deepCopyElements(ctx, setValue.Elements())
+
+		diags.Append(elementsDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		setValue, setDiags = basetypes.NewSetValue(setValue.ElementType(ctx), elements)
+
+		diags.Append(setDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, resultDiags := typable.ValueFromSet(ctx, setValue)
+
+		diags.Append(resultDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return result, diags
+	}
+
+	// Converting a value into a Terraform value and back always creates new
+	// underlying data, such as when reading numbers.
+	tfValue, err := v.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to copy a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	result, err := v.Type(ctx).ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to copy a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return result, diags
+}
+
+// deepCopyElements returns a DeepCopy of each of the given list or set
+// elements.
+func deepCopyElements(ctx context.Context, elements []attr.Value) ([]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := make([]attr.Value, 0, len(elements))
+
+	for _, element := range elements {
+		elementCopy, elementDiags := DeepCopy(ctx, element)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = append(result, elementCopy)
+	}
+
+	return result, diags
+}
+
+// deepCopyAttributes returns a DeepCopy of each of the given map elements or
+// object attributes.
+func deepCopyAttributes(ctx context.Context, attributes map[string]attr.Value) (map[string]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := make(map[string]attr.Value, len(attributes))
+
+	for name, attribute := range attributes {
+		attributeCopy, attributeDiags := DeepCopy(ctx, attribute)
+
+		diags.Append(attributeDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result[name] = attributeCopy
+	}
+
+	return result, diags
+}
+
+// deepCopyTypableDiagnostic returns an error diagnostic for a custom value
+// whose type does not implement the expected Typable interface.
+func deepCopyTypableDiagnostic(ctx context.Context, v attr.Value, typableName string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Value Conversion Error",
+		"An unexpected error was encountered trying to copy a value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+			fmt.Sprintf("Error: value type %T must implement %s", v.Type(ctx), typableName),
+	)
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDeepCopy(t *testing.T) {
+	t.Parallel()
+
+	objectAttrTypes := map[string]attr.Type{
+		"name":  types.StringType,
+		"count": types.NumberType,
+	}
+
+	testCases := map[string]struct {
+		value attr.Value
+	}{
+		"nil": {
+			value: nil,
+		},
+		"bool": {
+			value: types.BoolValue(true),
+		},
+		"custom-number": {
+			value: testtypes.NumberValueWithSemanticEquals{
+				NumberValue:    types.NumberValue(big.NewFloat(1.5)),
+				SemanticEquals: true,
+			},
+		},
+		"float64-infinity": {
+			value: types.Float64Value(math.Inf(1)),
+		},
+		"float64-negative-infinity": {
+			value: types.Float64Value(math.Inf(-1)),
+		},
+		"number": {
+			value: types.NumberValue(big.NewFloat(1.5)),
+		},
+		"number-infinity": {
+			value: types.NumberValue(big.NewFloat(math.Inf(1))),
+		},
+		"number-null": {
+			value: types.NumberNull(),
+		},
+		"number-unknown": {
+			value: types.NumberUnknown(),
+		},
+		"string": {
+			value: types.StringValue("test"),
+		},
+		"list": {
+			value: types.ListValueMust(
+				types.NumberType,
+				[]attr.Value{
+					types.NumberValue(big.NewFloat(1)),
+					types.NumberValue(big.NewFloat(2)),
+				},
+			),
+		},
+		"list-float64-infinity": {
+			value: types.ListValueMust(
+				types.Float64Type,
+				[]attr.Value{
+					types.Float64Value(math.Inf(1)),
+				},
+			),
+		},
+		"map": {
+			value: types.MapValueMust(
+				types.StringType,
+				map[string]attr.Value{
+					"key": types.StringValue("value"),
+				},
+			),
+		},
+		"object": {
+			value: types.ObjectValueMust(
+				objectAttrTypes,
+				map[string]attr.Value{
+					"name":  types.StringValue("test"),
+					"count": types.NumberUnknown(),
+				},
+			),
+		},
+		"set": {
+			value: types.SetValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("one"),
+					types.StringValue("two"),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := types.DeepCopy(context.Background(), testCase.value)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if testCase.value == nil {
+				if got != nil {
+					t.Fatalf("expected nil, got: %s", got)
+				}
+
+				return
+			}
+
+			if !got.Equal(testCase.value) {
+				t.Errorf("expected copy %s to equal %s", got, testCase.value)
+			}
+		})
+	}
+}
+
+func TestDeepCopy_independent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	number := types.NumberValue(big.NewFloat(1.5))
+	object := types.ObjectValueMust(
+		map[string]attr.Type{
+			"numbers": types.ListType{ElemType: types.NumberType},
+		},
+		map[string]attr.Value{
+			"numbers": types.ListValueMust(types.NumberType, []attr.Value{number}),
+		},
+	)
+
+	numberCopy, diags := types.DeepCopy(ctx, number)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	objectCopy, diags := types.DeepCopy(ctx, object)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Mutate the source value's underlying *big.Float.
+	number.ValueBigFloat().SetFloat64(2.5)
+
+	expectedNumber := types.NumberValue(big.NewFloat(1.5))
+
+	if !numberCopy.Equal(expectedNumber) {
+		t.Errorf("expected number copy to be unchanged, got: %s", numberCopy)
+	}
+
+	expectedObject := types.ObjectValueMust(
+		map[string]attr.Type{
+			"numbers": types.ListType{ElemType: types.NumberType},
+		},
+		map[string]attr.Value{
+			"numbers": types.ListValueMust(types.NumberType, []attr.Value{expectedNumber}),
+		},
+	)
+
+	if diff := cmp.Diff(objectCopy.String(), expectedObject.String()); diff != "" {
+		t.Errorf("expected object copy to be unchanged: %s", diff)
+	}
+
+	if !objectCopy.Equal(expectedObject) {
+		t.Errorf("expected object copy to be unchanged, got: %s", objectCopy)
+	}
+
+	if object.Equal(expectedObject) {
+		t.Error("expected source object to reflect the mutation")
+	}
+}
+
+func TestDeepCopy_nan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	got, diags := types.DeepCopy(ctx, types.ListValueMust(
+		types.Float64Type,
+		[]attr.Value{
+			types.Float64Value(math.NaN()),
+		},
+	))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	list, ok := got.(types.List)
+
+	if !ok {
+		t.Fatalf("expected types.List, got: %T", got)
+	}
+
+	elements := list.Elements()
+
+	if len(elements) != 1 {
+		t.Fatalf("expected 1 element, got: %d", len(elements))
+	}
+
+	element, ok := elements[0].(types.Float64)
+
+	if !ok {
+		t.Fatalf("expected types.Float64 element, got: %T", elements[0])
+	}
+
+	if !math.IsNaN(element.ValueFloat64()) {
+		t.Errorf("expected NaN element, got: %s", element)
+	}
+}
@@ -3,7 +3,10 @@
 
 package types
 
-import "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
 
 type Float64 = basetypes.Float64Value
 
@@ -29,3 +32,10 @@ func Float64Value(value float64) basetypes.Float64Value {
 func Float64PointerValue(value *float64) basetypes.Float64Value {
 	return basetypes.NewFloat64PointerValue(value)
 }
+
+// Float64ValueFromString creates a Float64 with a known value parsed from the given
+// string. If the string cannot be parsed, an unknown value is returned with an
+// error diagnostic.
+func Float64ValueFromString(value string) (basetypes.Float64Value, diag.Diagnostics) {
+	return basetypes.NewFloat64ValueFromString(value)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// goldenIndent is the indentation used for each nesting level of Golden.
+const goldenIndent = "  "
+
+// Golden returns a stable, indented, human-readable representation of the
+// given value, which is suitable for comparing against golden files in tests,
+// such as when verifying resource state. Unlike the compact String method of
+// values, list, map, object, and set elements are written on separate
+// indented lines, map keys and object attribute names are sorted, and set
+// elements are sorted by their representation. Values which are equal, but
+// were created differently, such as a set with elements added in a different
+// order, return the same representation.
+//
+// Null and unknown values, including a nil value, are represented as
+// attr.NullValueString and attr.UnknownValueString. If the value cannot be
+// converted into a Terraform value, the conversion error is returned in place
+// of the representation.
+//
+// The string returned here is not protected by any compatibility guarantees
+// across framework versions.
+func Golden(v attr.Value) string {
+	if v == nil {
+		return attr.NullValueString
+	}
+
+	tfValue, err := v.ToTerraformValue(context.Background())
+
+	if err != nil {
+		return fmt.Sprintf("<error: %s>", err)
+	}
+
+	var b strings.Builder
+
+	writeGolden(&b, tfValue, "")
+
+	return b.String()
+}
+
+// writeGolden writes the Golden representation of the given value, with
+// any nested lines prefixed by indent.
+func writeGolden(b *strings.Builder, v tftypes.Value, indent string) {
+	if !v.IsKnown() {
+		b.WriteString(attr.UnknownValueString)
+
+		return
+	}
+
+	if v.IsNull() {
+		b.WriteString(attr.NullValueString)
+
+		return
+	}
+
+	switch {
+	case v.Type().Is(tftypes.Bool):
+		var val bool
+
+		_ = v.As(&val)
+
+		fmt.Fprintf(b, "%t", val)
+	case v.Type().Is(tftypes.Number):
+		val := new(big.Float)
+
+		_ = v.As(&val)
+
+		b.WriteString(val.Text('g', -1))
+	case v.Type().Is(tftypes.String):
+		var val string
+
+		_ = v.As(&val)
+
+		fmt.Fprintf(b, "%q", val)
+	case v.Type().Is(tftypes.List{}), v.Type().Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+
+		_ = v.As(&elems)
+
+		lines := make([]string, 0, len(elems))
+
+		for _, elem := range elems {
+			lines = append(lines, goldenString(elem, indent+goldenIndent))
+		}
+
+		writeGoldenLines(b, "[", "]", lines, indent)
+	case v.Type().Is(tftypes.Set{}):
+		var elems []tftypes.Value
+
+		_ = v.As(&elems)
+
+		lines := make([]string, 0, len(elems))
+
+		for _, elem := range elems {
+			lines = append(lines, goldenString(elem, indent+goldenIndent))
+		}
+
+		// Sets are unordered, so ensure a stable representation.
+		sort.Strings(lines)
+
+		writeGoldenLines(b, "[", "]", lines, indent)
+	case v.Type().Is(tftypes.Map{}), v.Type().Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+
+		_ = v.As(&elems)
+
+		keys := make([]string, 0, len(elems))
+
+		for key := range elems {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		lines := make([]string, 0, len(keys))
+
+		for _, key := range keys {
+			lines = append(lines, fmt.Sprintf("%q: %s", key, goldenString(elems[key], indent+goldenIndent)))
+		}
+
+		writeGoldenLines(b, "{", "}", lines, indent)
+	default:
+		b.WriteString(v.String())
+	}
+}
+
+// goldenString returns the Golden representation of the given value, with
+// any nested lines prefixed by indent.
+func goldenString(v tftypes.Value, indent string) string {
+	var b strings.Builder
+
+	writeGolden(&b, v, indent)
+
+	return b.String()
+}
+
+// writeGoldenLines writes the given element lines between the opening and
+// closing delimiters, with each element on a separate line indented one level
+// deeper than indent.
+func writeGoldenLines(b *strings.Builder, openDelim string, closeDelim string, lines []string, indent string) {
+	b.WriteString(openDelim)
+
+	if len(lines) == 0 {
+		b.WriteString(closeDelim)
+
+		return
+	}
+
+	for i, line := range lines {
+		if i != 0 {
+			b.WriteString(",")
+		}
+
+		b.WriteString("\n")
+		b.WriteString(indent)
+		b.WriteString(goldenIndent)
+		b.WriteString(line)
+	}
+
+	b.WriteString("\n")
+	b.WriteString(indent)
+	b.WriteString(closeDelim)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGolden(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected string
+	}{
+		"nil": {
+			value:    nil,
+			expected: "<null>",
+		},
+		"bool": {
+			value:    types.BoolValue(true),
+			expected: "true",
+		},
+		"number": {
+			value:    types.NumberValue(big.NewFloat(1.5)),
+			expected: "1.5",
+		},
+		"int64": {
+			value:    types.Int64Value(123),
+			expected: "123",
+		},
+		"string": {
+			value:    types.StringValue("test"),
+			expected: `"test"`,
+		},
+		"string-null": {
+			value:    types.StringNull(),
+			expected: "<null>",
+		},
+		"string-unknown": {
+			value:    types.StringUnknown(),
+			expected: "<unknown>",
+		},
+		"list-empty": {
+			value:    types.ListValueMust(types.StringType, []attr.Value{}),
+			expected: "[]",
+		},
+		"list": {
+			value: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("b"),
+					types.StringValue("a"),
+				},
+			),
+			expected: `[
+  "b",
+  "a"
+]`,
+		},
+		"object": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"name": types.StringType,
+					"tags": types.MapType{ElemType: types.StringType},
+				},
+				map[string]attr.Value{
+					"name": types.StringValue("test"),
+					"tags": types.MapValueMust(
+						types.StringType,
+						map[string]attr.Value{
+							"b": types.StringValue("two"),
+							"a": types.StringUnknown(),
+						},
+					),
+				},
+			),
+			expected: `{
+  "name": "test",
+  "tags": {
+    "a": <unknown>,
+    "b": "two"
+  }
+}`,
+		},
+		"set": {
+			value: types.SetValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("b"),
+					types.StringValue("a"),
+				},
+			),
+			expected: `[
+  "a",
+  "b"
+]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := types.Golden(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGolden_equivalentValues(t *testing.T) {
+	t.Parallel()
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":   types.StringType,
+			"tags": types.SetType{ElemType: types.StringType},
+		},
+	}
+
+	first := types.ListValueMust(
+		objectType,
+		[]attr.Value{
+			types.ObjectValueMust(
+				objectType.AttrTypes,
+				map[string]attr.Value{
+					"id": types.StringValue("one"),
+					"tags": types.SetValueMust(
+						types.StringType,
+						[]attr.Value{
+							types.StringValue("x"),
+							types.StringValue("y"),
+							types.StringValue("z"),
+						},
+					),
+				},
+			),
+		},
+	)
+
+	second := types.ListValueMust(
+		objectType,
+		[]attr.Value{
+			types.ObjectValueMust(
+				objectType.AttrTypes,
+				map[string]attr.Value{
+					"tags": types.SetValueMust(
+						types.StringType,
+						[]attr.Value{
+							types.StringValue("z"),
+							types.StringValue("x"),
+							types.StringValue("y"),
+						},
+					),
+					"id": types.StringValue("one"),
+				},
+			),
+		},
+	)
+
+	expected := types.Golden(first)
+
+	// Repeat to verify map iteration order does not affect the output.
+	for i := 0; i < 10; i++ {
+		if diff := cmp.Diff(types.Golden(second), expected); diff != "" {
+			t.Fatalf("unexpected difference: %s", diff)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// hclIdentifierRegex matches object attribute names which can be written
+// without quotes in HCL syntax.
+var hclIdentifierRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// ToHCL returns the given value rendered as an HCL expression, such as for
+// generating example configuration. Strings are quoted and escaped, lists and
+// sets are rendered as tuple expressions ([...]), and maps and objects are
+// rendered as object expressions ({...}) with keys and attribute names in
+// sorted order. Null values are rendered as null.
+//
+// Unknown values and non-finite numbers cannot be represented in HCL syntax
+// and return an error diagnostic.
+func ToHCL(ctx context.Context, v attr.Value) (string, diag.Diagnostics) {
+	var builder strings.Builder
+
+	diags := writeHCL(ctx, &builder, path.Empty(), v)
+
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return builder.String(), diags
+}
+
+// writeHCL recursively writes the HCL syntax of a value to the builder.
+func writeHCL(ctx context.Context, b *strings.Builder, p path.Path, v attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v == nil || v.IsNull() {
+		b.WriteString("null")
+
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddAttributeError(
+			p,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert a value to HCL syntax. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				"Unknown values cannot be represented in HCL syntax.",
+		)
+
+		return diags
+	}
+
+	switch v := v.(type) {
+	case basetypes.BoolValuable:
+		boolValue, boolDiags := v.ToBoolValue(ctx)
+
+		diags.Append(boolDiags...)
+
+		b.WriteString(strconv.FormatBool(boolValue.ValueBool()))
+	case basetypes.Float64Valuable:
+		float64Value, float64Diags := v.ToFloat64Value(ctx)
+
+		diags.Append(float64Diags...)
+
+		value := float64Value.ValueFloat64()
+
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			diags.Append(nonFiniteNumberHCLDiag(p))
+
+			return diags
+		}
+
+		b.WriteString(strconv.FormatFloat(value, 'f', -1, 64))
+	case basetypes.Int64Valuable:
+		int64Value, int64Diags := v.ToInt64Value(ctx)
+
+		diags.Append(int64Diags...)
+
+		b.WriteString(strconv.FormatInt(int64Value.ValueInt64(), 10))
+	case basetypes.NumberValuable:
+		numberValue, numberDiags := v.ToNumberValue(ctx)
+
+		diags.Append(numberDiags...)
+
+		value := numberValue.ValueBigFloat()
+
+		if value.IsInf() {
+			diags.Append(nonFiniteNumberHCLDiag(p))
+
+			return diags
+		}
+
+		b.WriteString(value.Text('f', -1))
+	case basetypes.StringValuable:
+		stringValue, stringDiags := v.ToStringValue(ctx)
+
+		diags.Append(stringDiags...)
+
+		b.WriteString(quoteHCL(stringValue.ValueString()))
+	case basetypes.ListValuable:
+		listValue, listDiags := v.ToListValue(ctx)
+
+		diags.Append(listDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		b.WriteString("[")
+
+		for index, element := range listValue.Elements() {
+			if index > 0 {
+				b.WriteString(", ")
+			}
+
+			diags.Append(writeHCL(ctx, b, p.AtListIndex(index), element)...)
+		}
+
+		b.WriteString("]")
+	case basetypes.SetValuable:
+		setValue, setDiags := v.ToSetValue(ctx)
+
+		diags.Append(setDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		b.WriteString("[")
+
+		for index, element := range setValue.Elements() {
+			if index > 0 {
+				b.WriteString(", ")
+			}
+
+			diags.Append(writeHCL(ctx, b, p.AtSetValue(element), element)...)
+		}
+
+		b.WriteString("]")
+	case basetypes.MapValuable:
+		mapValue, mapDiags := v.ToMapValue(ctx)
+
+		diags.Append(mapDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		elements := mapValue.Elements()
+		keys := make([]string, 0, len(elements))
+
+		for key := range elements {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		b.WriteString("{")
+
+		for index, key := range keys {
+			if index > 0 {
+				b.WriteString(",")
+			}
+
+			b.WriteString(" " + quoteHCL(key) + " = ")
+
+			diags.Append(writeHCL(ctx, b, p.AtMapKey(key), elements[key])...)
+		}
+
+		if len(keys) > 0 {
+			b.WriteString(" ")
+		}
+
+		b.WriteString("}")
+	case basetypes.ObjectValuable:
+		objectValue, objectDiags := v.ToObjectValue(ctx)
+
+		diags.Append(objectDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		attributes := objectValue.Attributes()
+		names := make([]string, 0, len(attributes))
+
+		for name := range attributes {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		b.WriteString("{")
+
+		for index, name := range names {
+			if index > 0 {
+				b.WriteString(",")
+			}
+
+			if hclIdentifierRegex.MatchString(name) {
+				b.WriteString(" " + name + " = ")
+			} else {
+				b.WriteString(" " + quoteHCL(name) + " = ")
+			}
+
+			diags.Append(writeHCL(ctx, b, p.AtName(name), attributes[name])...)
+		}
+
+		if len(names) > 0 {
+			b.WriteString(" ")
+		}
+
+		b.WriteString("}")
+	default:
+		diags.AddAttributeError(
+			p,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert a value to HCL syntax. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				fmt.Sprintf("Unsupported value type: %T", v),
+		)
+	}
+
+	return diags
+}
+
+// quoteHCL returns the given string as a quoted HCL string literal. Template
+// sequences are escaped so the string is rendered literally.
+func quoteHCL(s string) string {
+	var b strings.Builder
+
+	b.WriteString(`"`)
+
+	for index, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$', '%':
+			b.WriteRune(r)
+
+			// Escape template interpolation and directive sequences.
+			if strings.HasPrefix(s[index+1:], "{") {
+				b.WriteRune(r)
+			}
+		default:
+			if r < 0x20 || r == 0x7f {
+				b.WriteString(fmt.Sprintf(`\u%04x`, r))
+
+				continue
+			}
+
+			b.WriteRune(r)
+		}
+	}
+
+	b.WriteString(`"`)
+
+	return b.String()
+}
+
+// nonFiniteNumberHCLDiag returns an error diagnostic for a number which
+// cannot be represented in HCL syntax.
+func nonFiniteNumberHCLDiag(p path.Path) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Value Conversion Error",
+		"An unexpected error was encountered trying to convert a value to HCL syntax. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+			"Non-finite numbers cannot be represented in HCL syntax.",
+	)
+}
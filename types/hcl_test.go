@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestToHCL(t *testing.T) {
+	t.Parallel()
+
+	nestedObjectType := map[string]attr.Type{
+		"enabled": types.BoolType,
+		"tags":    types.MapType{ElemType: types.StringType},
+	}
+
+	testCases := map[string]struct {
+		value         attr.Value
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"bool": {
+			value:    types.BoolValue(true),
+			expected: `true`,
+		},
+		"float64": {
+			value:    types.Float64Value(1.25),
+			expected: `1.25`,
+		},
+		"float64-infinity": {
+			value: types.Float64Value(math.Inf(1)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert a value to HCL syntax. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Non-finite numbers cannot be represented in HCL syntax.",
+				),
+			},
+		},
+		"int64": {
+			value:    types.Int64Value(-123),
+			expected: `-123`,
+		},
+		"number": {
+			value:    types.NumberValue(big.NewFloat(1234567.5)),
+			expected: `1234567.5`,
+		},
+		"string": {
+			value:    types.StringValue("test"),
+			expected: `"test"`,
+		},
+		"string-escapes": {
+			value:    types.StringValue("quote\" backslash\\ newline\n ${interpolation} %{directive} $literal"),
+			expected: `"quote\" backslash\\ newline\n $${interpolation} %%{directive} $literal"`,
+		},
+		"null": {
+			value:    types.StringNull(),
+			expected: `null`,
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert a value to HCL syntax. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unknown values cannot be represented in HCL syntax.",
+				),
+			},
+		},
+		"list": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("two"),
+			}),
+			expected: `["one", "two"]`,
+		},
+		"list-empty": {
+			value:    types.ListValueMust(types.StringType, []attr.Value{}),
+			expected: `[]`,
+		},
+		"set": {
+			value: types.SetValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(2),
+				types.Int64Value(1),
+			}),
+			expected: `[1, 2]`,
+		},
+		"map": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"b":          types.StringValue("two"),
+				"a":          types.StringValue("one"),
+				"not-an-id!": types.StringNull(),
+			}),
+			expected: `{ "a" = "one", "b" = "two", "not-an-id!" = null }`,
+		},
+		"object-empty": {
+			value:    types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}),
+			expected: `{}`,
+		},
+		"object-nested": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"name": types.StringType,
+					"nested": types.ListType{
+						ElemType: types.ObjectType{AttrTypes: nestedObjectType},
+					},
+				},
+				map[string]attr.Value{
+					"name": types.StringValue("test"),
+					"nested": types.ListValueMust(
+						types.ObjectType{AttrTypes: nestedObjectType},
+						[]attr.Value{
+							types.ObjectValueMust(
+								nestedObjectType,
+								map[string]attr.Value{
+									"enabled": types.BoolValue(false),
+									"tags": types.MapValueMust(types.StringType, map[string]attr.Value{
+										"env": types.StringValue("test"),
+									}),
+								},
+							),
+						},
+					),
+				},
+			),
+			expected: `{ name = "test", nested = [{ enabled = false, tags = { "env" = "test" } }] }`,
+		},
+		"object-nested-unknown": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"list": types.ListType{ElemType: types.StringType},
+				},
+				map[string]attr.Value{
+					"list": types.ListValueMust(types.StringType, []attr.Value{
+						types.StringUnknown(),
+					}),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list").AtListIndex(0),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert a value to HCL syntax. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unknown values cannot be represented in HCL syntax.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := types.ToHCL(context.Background(), testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
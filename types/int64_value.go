@@ -3,7 +3,10 @@
 
 package types
 
-import "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
 
 type Int64 = basetypes.Int64Value
 
@@ -29,3 +32,10 @@ func Int64Value(value int64) basetypes.Int64Value {
 func Int64PointerValue(value *int64) basetypes.Int64Value {
 	return basetypes.NewInt64PointerValue(value)
 }
+
+// Int64ValueFromString creates an Int64 with a known value parsed from the given
+// string. If the string cannot be parsed, an unknown value is returned with an
+// error diagnostic.
+func Int64ValueFromString(value string) (basetypes.Int64Value, diag.Diagnostics) {
+	return basetypes.NewInt64ValueFromString(value)
+}
@@ -34,8 +34,11 @@ func ListValue(elementType attr.Type, elements []attr.Value) (basetypes.ListValu
 // ListValueFrom creates a List with a known value, using reflection rules.
 // The elements must be a slice which can convert into the given element type.
 // Access the value via the List type Elements or ElementsAs methods.
-func ListValueFrom(ctx context.Context, elementType attr.Type, elements any) (basetypes.ListValue, diag.Diagnostics) {
-	return basetypes.NewListValueFrom(ctx, elementType, elements)
+//
+// Use ValueFromOption, such as WithEmptyAsNull, to modify the reflection
+// behavior.
+func ListValueFrom(ctx context.Context, elementType attr.Type, elements any, opts ...ValueFromOption) (basetypes.ListValue, diag.Diagnostics) {
+	return basetypes.NewListValueFrom(ctx, elementType, elements, opts...)
 }
 
 // ListValueMust creates a List with a known value, converting any diagnostics
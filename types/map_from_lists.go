@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// MapFromLists creates a Map by pairing each element of the keys List with
+// the element at the same index of the values List. The keys List must
+// contain known, non-null string elements without duplicates and must have
+// the same length as the values List. The element type of the resulting Map
+// is the element type of the values List.
+//
+// If either List is unknown, an unknown Map is returned. Otherwise if either
+// List is null, a null Map is returned.
+func MapFromLists(ctx context.Context, keys List, values List) (Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elementType := values.ElementType(ctx)
+
+	if keys.IsUnknown() || values.IsUnknown() {
+		return basetypes.NewMapUnknown(elementType), diags
+	}
+
+	if keys.IsNull() || values.IsNull() {
+		return basetypes.NewMapNull(elementType), diags
+	}
+
+	if _, ok := keys.ElementType(ctx).ValueType(ctx).(basetypes.StringValuable); !ok {
+		diags.AddError(
+			"Invalid Map Keys",
+			"While creating a Map value from lists, the keys list element type was not a string type. "+
+				"This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				fmt.Sprintf("Keys List Element Type: %s", keys.ElementType(ctx)),
+		)
+
+		return basetypes.NewMapUnknown(elementType), diags
+	}
+
+	keyElements := keys.Elements()
+	valueElements := values.Elements()
+
+	if len(keyElements) != len(valueElements) {
+		diags.AddError(
+			"Invalid Map Lists",
+			"While creating a Map value from lists, the keys and values lists had different lengths. "+
+				"This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				fmt.Sprintf("Keys List Length: %d\nValues List Length: %d", len(keyElements), len(valueElements)),
+		)
+
+		return basetypes.NewMapUnknown(elementType), diags
+	}
+
+	elements := make(map[string]attr.Value, len(keyElements))
+
+	for idx, keyElement := range keyElements {
+		// Type was verified above.
+		//nolint:forcetypeassert
+		key, keyDiags := keyElement.(basetypes.StringValuable).ToStringValue(ctx)
+
+		diags.Append(keyDiags...)
+
+		if diags.HasError() {
+			return basetypes.NewMapUnknown(elementType), diags
+		}
+
+		if key.IsNull() || key.IsUnknown() {
+			diags.AddError(
+				"Invalid Map Keys",
+				"While creating a Map value from lists, a keys list element was null or unknown. "+
+					"This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+					fmt.Sprintf("Keys List Index: %d\nKeys List Element: %s", idx, keyElement),
+			)
+
+			return basetypes.NewMapUnknown(elementType), diags
+		}
+
+		if _, ok := elements[key.ValueString()]; ok {
+			diags.AddError(
+				"Invalid Map Keys",
+				"While creating a Map value from lists, the keys list contained a duplicate key. "+
+					"This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+					fmt.Sprintf("Duplicate Key: %q", key.ValueString()),
+			)
+
+			return basetypes.NewMapUnknown(elementType), diags
+		}
+
+		elements[key.ValueString()] = valueElements[idx]
+	}
+
+	result, mapDiags := basetypes.NewMapValue(elementType, elements)
+
+	diags.Append(mapDiags...)
+
+	return result, diags
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMapFromLists(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		keys          types.List
+		values        types.List
+		expected      types.Map
+		expectedDiags diag.Diagnostics
+	}{
+		"matching-lengths": {
+			keys: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("one"),
+					types.StringValue("two"),
+				},
+			),
+			values: types.ListValueMust(
+				types.Int64Type,
+				[]attr.Value{
+					types.Int64Value(1),
+					types.Int64Value(2),
+				},
+			),
+			expected: types.MapValueMust(
+				types.Int64Type,
+				map[string]attr.Value{
+					"one": types.Int64Value(1),
+					"two": types.Int64Value(2),
+				},
+			),
+		},
+		"empty": {
+			keys:     types.ListValueMust(types.StringType, []attr.Value{}),
+			values:   types.ListValueMust(types.Int64Type, []attr.Value{}),
+			expected: types.MapValueMust(types.Int64Type, map[string]attr.Value{}),
+		},
+		"keys-null": {
+			keys:     types.ListNull(types.StringType),
+			values:   types.ListValueMust(types.Int64Type, []attr.Value{}),
+			expected: types.MapNull(types.Int64Type),
+		},
+		"values-unknown": {
+			keys:     types.ListValueMust(types.StringType, []attr.Value{}),
+			values:   types.ListUnknown(types.Int64Type),
+			expected: types.MapUnknown(types.Int64Type),
+		},
+		"mismatched-lengths": {
+			keys: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("one"),
+				},
+			),
+			values: types.ListValueMust(
+				types.Int64Type,
+				[]attr.Value{
+					types.Int64Value(1),
+					types.Int64Value(2),
+				},
+			),
+			expected: types.MapUnknown(types.Int64Type),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Lists",
+					"While creating a Map value from lists, the keys and values lists had different lengths. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Keys List Length: 1\nValues List Length: 2",
+				),
+			},
+		},
+		"non-string-keys": {
+			keys: types.ListValueMust(
+				types.Int64Type,
+				[]attr.Value{
+					types.Int64Value(1),
+				},
+			),
+			values: types.ListValueMust(
+				types.Int64Type,
+				[]attr.Value{
+					types.Int64Value(1),
+				},
+			),
+			expected: types.MapUnknown(types.Int64Type),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Keys",
+					"While creating a Map value from lists, the keys list element type was not a string type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Keys List Element Type: basetypes.Int64Type",
+				),
+			},
+		},
+		"null-key": {
+			keys: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringNull(),
+				},
+			),
+			values: types.ListValueMust(
+				types.Int64Type,
+				[]attr.Value{
+					types.Int64Value(1),
+				},
+			),
+			expected: types.MapUnknown(types.Int64Type),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Keys",
+					"While creating a Map value from lists, a keys list element was null or unknown. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Keys List Index: 0\nKeys List Element: <null>",
+				),
+			},
+		},
+		"duplicate-keys": {
+			keys: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("one"),
+					types.StringValue("one"),
+				},
+			),
+			values: types.ListValueMust(
+				types.Int64Type,
+				[]attr.Value{
+					types.Int64Value(1),
+					types.Int64Value(2),
+				},
+			),
+			expected: types.MapUnknown(types.Int64Type),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Keys",
+					"While creating a Map value from lists, the keys list contained a duplicate key. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`Duplicate Key: "one"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := types.MapFromLists(context.Background(), testCase.keys, testCase.values)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -34,8 +34,11 @@ func MapValue(elementType attr.Type, elements map[string]attr.Value) (basetypes.
 // MapValueFrom creates a Map with a known value, using reflection rules.
 // The elements must be a map which can convert into the given element type.
 // Access the value via the Map type Elements or ElementsAs methods.
-func MapValueFrom(ctx context.Context, elementType attr.Type, elements any) (basetypes.MapValue, diag.Diagnostics) {
-	return basetypes.NewMapValueFrom(ctx, elementType, elements)
+//
+// Use ValueFromOption, such as WithEmptyAsNull, to modify the reflection
+// behavior.
+func MapValueFrom(ctx context.Context, elementType attr.Type, elements any, opts ...ValueFromOption) (basetypes.MapValue, diag.Diagnostics) {
+	return basetypes.NewMapValueFrom(ctx, elementType, elements, opts...)
 }
 
 // MapValueMust creates a Map with a known value, converting any diagnostics
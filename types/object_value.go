@@ -34,8 +34,18 @@ func ObjectValue(attributeTypes map[string]attr.Type, attributes map[string]attr
 // ObjectValueFrom creates a Object with a known value, using reflection rules.
 // The attributes must be a struct which can convert into the given attribute types.
 // Access the value via the Object type Attributes or As methods.
-func ObjectValueFrom(ctx context.Context, attributeTypes map[string]attr.Type, attributes any) (basetypes.ObjectValue, diag.Diagnostics) {
-	return basetypes.NewObjectValueFrom(ctx, attributeTypes, attributes)
+//
+// Use ValueFromOption, such as WithEmptyAsNull, to modify the reflection
+// behavior.
+func ObjectValueFrom(ctx context.Context, attributeTypes map[string]attr.Type, attributes any, opts ...ValueFromOption) (basetypes.ObjectValue, diag.Diagnostics) {
+	return basetypes.NewObjectValueFrom(ctx, attributeTypes, attributes, opts...)
+}
+
+// ObjectValueInferred creates a Object with a known value, where the attribute
+// types are inferred from the Type of each attribute value. Access the value
+// via the Object type Attributes or As methods.
+func ObjectValueInferred(attributes map[string]attr.Value) (basetypes.ObjectValue, diag.Diagnostics) {
+	return basetypes.NewObjectValueInferred(attributes)
 }
 
 // ObjectValueMust creates a Object with a known value, converting any diagnostics
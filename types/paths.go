@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Paths returns the path of every leaf in the given value, relative to the
+// value itself. Known lists, maps, objects, and sets are traversed into their
+// elements or attributes, while all other values, including null, unknown,
+// and empty collections or objects, are considered leaves. Map keys and
+// object attribute names are returned in sorted order.
+//
+// A value which is itself a leaf returns a single empty path.
+func Paths(ctx context.Context, v attr.Value) (path.Paths, diag.Diagnostics) {
+	return valuePaths(ctx, path.Empty(), v)
+}
+
+// valuePaths recursively collects the leaf paths of a value underneath the
+// given path.
+func valuePaths(ctx context.Context, p path.Path, v attr.Value) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return path.Paths{p}, diags
+	}
+
+	var result path.Paths
+
+	switch v := v.(type) {
+	case basetypes.ListValuable:
+		listValue, listDiags := v.ToListValue(ctx)
+
+		diags.Append(listDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		elements := listValue.Elements()
+
+		if len(elements) == 0 {
+			return path.Paths{p}, diags
+		}
+
+		for index, element := range elements {
+			elementPaths, elementDiags := valuePaths(ctx, p.AtListIndex(index), element)
+
+			diags.Append(elementDiags...)
+
+			result.Append(elementPaths...)
+		}
+	case basetypes.MapValuable:
+		mapValue, mapDiags := v.ToMapValue(ctx)
+
+		diags.Append(mapDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		elements := mapValue.Elements()
+
+		if len(elements) == 0 {
+			return path.Paths{p}, diags
+		}
+
+		keys := make([]string, 0, len(elements))
+
+		for key := range elements {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			elementPaths, elementDiags := valuePaths(ctx, p.AtMapKey(key), elements[key])
+
+			diags.Append(elementDiags...)
+
+			result.Append(elementPaths...)
+		}
+	case basetypes.ObjectValuable:
+		objectValue, objectDiags := v.ToObjectValue(ctx)
+
+		diags.Append(objectDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		attributes := objectValue.Attributes()
+
+		if len(attributes) == 0 {
+			return path.Paths{p}, diags
+		}
+
+		names := make([]string, 0, len(attributes))
+
+		for name := range attributes {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			attributePaths, attributeDiags := valuePaths(ctx, p.AtName(name), attributes[name])
+
+			diags.Append(attributeDiags...)
+
+			result.Append(attributePaths...)
+		}
+	case basetypes.SetValuable:
+		setValue, setDiags := v.ToSetValue(ctx)
+
+		diags.Append(setDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		elements := setValue.Elements()
+
+		if len(elements) == 0 {
+			return path.Paths{p}, diags
+		}
+
+		for _, element := range elements {
+			elementPaths, elementDiags := valuePaths(ctx, p.AtSetValue(element), element)
+
+			diags.Append(elementDiags...)
+
+			result.Append(elementPaths...)
+		}
+	default:
+		return path.Paths{p}, diags
+	}
+
+	return result, diags
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPaths(t *testing.T) {
+	t.Parallel()
+
+	nestedObjectType := map[string]attr.Type{
+		"list": types.ListType{ElemType: types.StringType},
+		"map":  types.MapType{ElemType: types.Int64Type},
+	}
+
+	testCases := map[string]struct {
+		value         attr.Value
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		"primitive": {
+			value:    types.StringValue("test"),
+			expected: path.Paths{path.Empty()},
+		},
+		"null-list": {
+			value:    types.ListNull(types.StringType),
+			expected: path.Paths{path.Empty()},
+		},
+		"unknown-object": {
+			value:    types.ObjectUnknown(nestedObjectType),
+			expected: path.Paths{path.Empty()},
+		},
+		"set": {
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+			}),
+			expected: path.Paths{
+				path.Empty().AtSetValue(types.StringValue("one")),
+			},
+		},
+		"nested-object": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"bool":   types.BoolType,
+					"nested": types.ObjectType{AttrTypes: nestedObjectType},
+					"null":   types.StringType,
+					"empty":  types.ListType{ElemType: types.StringType},
+				},
+				map[string]attr.Value{
+					"bool": types.BoolValue(true),
+					"nested": types.ObjectValueMust(
+						nestedObjectType,
+						map[string]attr.Value{
+							"list": types.ListValueMust(types.StringType, []attr.Value{
+								types.StringValue("zero"),
+								types.StringValue("one"),
+							}),
+							"map": types.MapValueMust(types.Int64Type, map[string]attr.Value{
+								"b": types.Int64Value(2),
+								"a": types.Int64Value(1),
+							}),
+						},
+					),
+					"null":  types.StringNull(),
+					"empty": types.ListValueMust(types.StringType, []attr.Value{}),
+				},
+			),
+			expected: path.Paths{
+				path.Root("bool"),
+				path.Root("empty"),
+				path.Root("nested").AtName("list").AtListIndex(0),
+				path.Root("nested").AtName("list").AtListIndex(1),
+				path.Root("nested").AtName("map").AtMapKey("a"),
+				path.Root("nested").AtName("map").AtMapKey("b"),
+				path.Root("null"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := types.Paths(context.Background(), testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// primitiveValueShape is the constructor and accessor shape shared by the
+// Bool, Float64, Int64, and String types, so examples for one type transfer
+// directly to the others.
+type primitiveValueShape[T comparable, V attr.Value] struct {
+	null         func() V
+	unknown      func() V
+	value        func(T) V
+	pointerValue func(*T) V
+	valueT       func(V) T
+	valueTPtr    func(V) *T
+	example      T
+}
+
+func (s primitiveValueShape[T, V]) test(t *testing.T) {
+	t.Helper()
+
+	var zero T
+
+	if got := s.valueT(s.null()); got != zero {
+		t.Errorf("expected zero value for null, got: %v", got)
+	}
+
+	if got := s.valueT(s.unknown()); got != zero {
+		t.Errorf("expected zero value for unknown, got: %v", got)
+	}
+
+	if got := s.valueT(s.value(s.example)); got != s.example {
+		t.Errorf("expected %v for known, got: %v", s.example, got)
+	}
+
+	if got := s.valueTPtr(s.null()); got != nil {
+		t.Errorf("expected nil pointer for null, got: %v", *got)
+	}
+
+	if got := s.valueTPtr(s.unknown()); got == nil || *got != zero {
+		t.Errorf("expected pointer to zero value for unknown, got: %v", got)
+	}
+
+	if got := s.valueTPtr(s.value(s.example)); got == nil || *got != s.example {
+		t.Errorf("expected pointer to %v for known, got: %v", s.example, got)
+	}
+
+	if got := s.pointerValue(nil); !got.IsNull() {
+		t.Errorf("expected null value for nil pointer, got: %s", got)
+	}
+
+	example := s.example
+
+	if diff := cmp.Diff(s.pointerValue(&example), s.value(s.example)); diff != "" {
+		t.Errorf("unexpected pointer value difference: %s", diff)
+	}
+
+	if got := s.null(); !got.IsNull() || got.IsUnknown() {
+		t.Errorf("expected null value, got: %s", got)
+	}
+
+	if got := s.unknown(); got.IsNull() || !got.IsUnknown() {
+		t.Errorf("expected unknown value, got: %s", got)
+	}
+
+	if got := s.value(s.example); got.IsNull() || got.IsUnknown() {
+		t.Errorf("expected known value, got: %s", got)
+	}
+}
+
+func TestPrimitiveValueShape(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(*testing.T){
+		"Bool": primitiveValueShape[bool, types.Bool]{
+			null:         types.BoolNull,
+			unknown:      types.BoolUnknown,
+			value:        types.BoolValue,
+			pointerValue: types.BoolPointerValue,
+			valueT:       types.Bool.ValueBool,
+			valueTPtr:    types.Bool.ValueBoolPointer,
+			example:      true,
+		}.test,
+		"Float64": primitiveValueShape[float64, types.Float64]{
+			null:         types.Float64Null,
+			unknown:      types.Float64Unknown,
+			value:        types.Float64Value,
+			pointerValue: types.Float64PointerValue,
+			valueT:       types.Float64.ValueFloat64,
+			valueTPtr:    types.Float64.ValueFloat64Pointer,
+			example:      1.2,
+		}.test,
+		"Int64": primitiveValueShape[int64, types.Int64]{
+			null:         types.Int64Null,
+			unknown:      types.Int64Unknown,
+			value:        types.Int64Value,
+			pointerValue: types.Int64PointerValue,
+			valueT:       types.Int64.ValueInt64,
+			valueTPtr:    types.Int64.ValueInt64Pointer,
+			example:      123,
+		}.test,
+		"String": primitiveValueShape[string, types.String]{
+			null:         types.StringNull,
+			unknown:      types.StringUnknown,
+			value:        types.StringValue,
+			pointerValue: types.StringPointerValue,
+			valueT:       types.String.ValueString,
+			valueTPtr:    types.String.ValueStringPointer,
+			example:      "test",
+		}.test,
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase(t)
+		})
+	}
+}
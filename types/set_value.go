@@ -34,8 +34,11 @@ func SetValue(elementType attr.Type, elements []attr.Value) (basetypes.SetValue,
 // SetValueFrom creates a Set with a known value, using reflection rules.
 // The elements must be a slice which can convert into the given element type.
 // Access the value via the Set type Elements or ElementsAs methods.
-func SetValueFrom(ctx context.Context, elementType attr.Type, elements any) (basetypes.SetValue, diag.Diagnostics) {
-	return basetypes.NewSetValueFrom(ctx, elementType, elements)
+//
+// Use ValueFromOption, such as WithEmptyAsNull, to modify the reflection
+// behavior.
+func SetValueFrom(ctx context.Context, elementType attr.Type, elements any, opts ...ValueFromOption) (basetypes.SetValue, diag.Diagnostics) {
+	return basetypes.NewSetValueFrom(ctx, elementType, elements, opts...)
 }
 
 // SetValueMust creates a Set with a known value, converting any diagnostics
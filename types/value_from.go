@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ValueFrom creates a value of the given target type from the given Go value,
+// using reflection rules. This generalizes the type specific constructors,
+// such as ListValueFrom and ObjectValueFrom, to any Go value and attr.Type
+// pair, which is useful when the target type is only known at runtime.
+//
+// If the Go value cannot be converted into the target type, error diagnostics
+// are returned with a nil value.
+func ValueFrom(ctx context.Context, goValue any, targetType attr.Type) (attr.Value, diag.Diagnostics) {
+	if targetType == nil {
+		return nil, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Missing Value Target Type",
+				"While creating a value from a Go value, a missing target type was detected. "+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			),
+		}
+	}
+
+	v, diags := reflect.FromValue(ctx, targetType, goValue, reflect.Options{}, path.Empty())
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return v, diags
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValueFromOption is a function which modifies the behavior of reflection-based
+// value creation, such as ListValueFrom and ObjectValueFrom.
+type ValueFromOption = basetypes.ValueFromOption
+
+// WithEmptyAsNull returns a ValueFromOption which sets whether empty Go slices
+// and maps are converted into null collection values instead of empty
+// collection values.
+func WithEmptyAsNull(emptyAsNull bool) ValueFromOption {
+	return basetypes.WithEmptyAsNull(emptyAsNull)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ValueFromTerraform creates a value of the given type from the given
+// Terraform value by calling the ValueFromTerraform method of the type. Any
+// conversion error is returned as an error diagnostic, rather than an error,
+// which simplifies converting raw Terraform values in testing and debugging
+// tools.
+//
+// If the Terraform value cannot be converted, error diagnostics are returned
+// with a nil value.
+func ValueFromTerraform(ctx context.Context, t attr.Type, v tftypes.Value) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if t == nil {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				"Missing value type.",
+		)
+
+		return nil, diags
+	}
+
+	result, err := t.ValueFromTerraform(ctx, v)
+
+	if err != nil {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. "+diag.ProviderDeveloperReportMessage+"\n\n"+
+				err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return result, diags
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		value         tftypes.Value
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"BoolType": {
+			typ:      types.BoolType,
+			value:    tftypes.NewValue(tftypes.Bool, true),
+			expected: types.BoolValue(true),
+		},
+		"BoolType-null": {
+			typ:      types.BoolType,
+			value:    tftypes.NewValue(tftypes.Bool, nil),
+			expected: types.BoolNull(),
+		},
+		"BoolType-unknown": {
+			typ:      types.BoolType,
+			value:    tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			expected: types.BoolUnknown(),
+		},
+		"Float64Type": {
+			typ:      types.Float64Type,
+			value:    tftypes.NewValue(tftypes.Number, big.NewFloat(1.2)),
+			expected: types.Float64Value(1.2),
+		},
+		"Int64Type": {
+			typ:      types.Int64Type,
+			value:    tftypes.NewValue(tftypes.Number, big.NewFloat(123)),
+			expected: types.Int64Value(123),
+		},
+		"ListType": {
+			typ: types.ListType{ElemType: types.StringType},
+			value: tftypes.NewValue(
+				tftypes.List{ElementType: tftypes.String},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "test"),
+				},
+			),
+			expected: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("test"),
+				},
+			),
+		},
+		"MapType": {
+			typ: types.MapType{ElemType: types.StringType},
+			value: tftypes.NewValue(
+				tftypes.Map{ElementType: tftypes.String},
+				map[string]tftypes.Value{
+					"key": tftypes.NewValue(tftypes.String, "test"),
+				},
+			),
+			expected: types.MapValueMust(
+				types.StringType,
+				map[string]attr.Value{
+					"key": types.StringValue("test"),
+				},
+			),
+		},
+		"NumberType": {
+			typ:      types.NumberType,
+			value:    tftypes.NewValue(tftypes.Number, big.NewFloat(1.2)),
+			expected: types.NumberValue(big.NewFloat(1.2)),
+		},
+		"ObjectType": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"attr": types.StringType,
+				},
+			},
+			value: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"attr": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"attr": tftypes.NewValue(tftypes.String, "test"),
+				},
+			),
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{
+					"attr": types.StringType,
+				},
+				map[string]attr.Value{
+					"attr": types.StringValue("test"),
+				},
+			),
+		},
+		"SetType": {
+			typ: types.SetType{ElemType: types.StringType},
+			value: tftypes.NewValue(
+				tftypes.Set{ElementType: tftypes.String},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "test"),
+				},
+			),
+			expected: types.SetValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("test"),
+				},
+			),
+		},
+		"StringType": {
+			typ:      types.StringType,
+			value:    tftypes.NewValue(tftypes.String, "test"),
+			expected: types.StringValue("test"),
+		},
+		"type-mismatch": {
+			typ:      types.StringType,
+			value:    tftypes.NewValue(tftypes.Bool, true),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"missing-type": {
+			value:    tftypes.NewValue(tftypes.String, "test"),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Missing value type.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := types.ValueFromTerraform(context.Background(), testCase.typ, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		goValue       any
+		targetType    attr.Type
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"string-StringType": {
+			goValue:    "test",
+			targetType: types.StringType,
+			expected:   types.StringValue("test"),
+		},
+		"string-slice-ListType": {
+			goValue:    []string{"one", "two"},
+			targetType: types.ListType{ElemType: types.StringType},
+			expected: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("one"),
+					types.StringValue("two"),
+				},
+			),
+		},
+		"nil-string-slice-ListType": {
+			goValue:    []string(nil),
+			targetType: types.ListType{ElemType: types.StringType},
+			expected:   types.ListNull(types.StringType),
+		},
+		"int-StringType-mismatch": {
+			goValue:    123,
+			targetType: types.StringType,
+			expected:   nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Number into *string, expected string",
+				),
+			},
+		},
+		"missing-target-type": {
+			goValue:  "test",
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Value Target Type",
+					"While creating a value from a Go value, a missing target type was detected. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := types.ValueFrom(context.Background(), testCase.goValue, testCase.targetType)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}